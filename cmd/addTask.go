@@ -11,6 +11,7 @@ import (
 	"github.com/hatappi/gomodoro/internal/api/server"
 	"github.com/hatappi/gomodoro/internal/client/graphql"
 	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
 	"github.com/hatappi/gomodoro/internal/editor"
 )
 
@@ -24,16 +25,25 @@ func newAddTaskCmd() *cobra.Command {
 Please specify the task name in the argument.
 if you doesn't specify task name, editor starts up.
 And add a task using the editor.
+The project, tags, estimate and notes flags apply to every added task.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
+			details, err := taskDetailsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			cfg, err := config.GetConfig()
 			if err != nil {
 				return fmt.Errorf("failed to get config: %w", err)
 			}
 
-			serverRunner := server.NewRunner(cfg)
+			serverRunner, err := server.NewRunner(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create server runner: %w", err)
+			}
 
 			if err := serverRunner.EnsureRunning(ctx); err != nil {
 				log.FromContext(ctx).Error(err, "Failed to ensure API server is running")
@@ -69,7 +79,7 @@ And add a task using the editor.
 			gqlClient := graphql.NewClientWrapper(cfg.API)
 
 			for _, newTaskTitle := range newTasks {
-				task, err := gqlClient.CreateTask(ctx, newTaskTitle)
+				task, err := gqlClient.CreateTask(ctx, newTaskTitle, details)
 				if err != nil {
 					return fmt.Errorf("failed to create task '%s': %w", newTaskTitle, err)
 				}
@@ -81,5 +91,39 @@ And add a task using the editor.
 		},
 	}
 
+	addTaskCmd.Flags().StringP("project", "p", "", "project of the task")
+	addTaskCmd.Flags().StringSliceP("tag", "t", nil, "tag of the task (repeatable or comma separated)")
+	addTaskCmd.Flags().IntP("estimate", "e", 0, "estimated number of pomodoros")
+	addTaskCmd.Flags().StringP("notes", "n", "", "notes of the task in markdown")
+
 	return addTaskCmd
 }
+
+func taskDetailsFromFlags(cmd *cobra.Command) (core.TaskDetails, error) {
+	project, err := cmd.Flags().GetString("project")
+	if err != nil {
+		return core.TaskDetails{}, fmt.Errorf("failed to get project flag: %w", err)
+	}
+
+	tags, err := cmd.Flags().GetStringSlice("tag")
+	if err != nil {
+		return core.TaskDetails{}, fmt.Errorf("failed to get tag flag: %w", err)
+	}
+
+	estimate, err := cmd.Flags().GetInt("estimate")
+	if err != nil {
+		return core.TaskDetails{}, fmt.Errorf("failed to get estimate flag: %w", err)
+	}
+
+	notes, err := cmd.Flags().GetString("notes")
+	if err != nil {
+		return core.TaskDetails{}, fmt.Errorf("failed to get notes flag: %w", err)
+	}
+
+	return core.TaskDetails{
+		Project:            project,
+		Tags:               tags,
+		EstimatedPomodoros: estimate,
+		Notes:              notes,
+	}, nil
+}
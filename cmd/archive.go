@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/archive"
+	"github.com/hatappi/gomodoro/internal/client/graphql"
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/driver"
+)
+
+func newExportCmd() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "export all data to a JSON archive",
+		Long: `This command writes the tasks, the session history, the latest session
+and a snapshot of the configuration to a versioned JSON archive.
+The archive can be read back with the import command, also by another storage driver.
+Credentials in the configuration are not exported.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return fmt.Errorf("failed to get output flag: %w", err)
+			}
+
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+
+			store, err := driver.Open(cfg.Storage)
+			if err != nil {
+				return fmt.Errorf("failed to open storage: %w", err)
+			}
+			defer closeStorage(cmd, store)
+
+			a, err := archive.Export(store, time.Now())
+			if err != nil {
+				return err
+			}
+
+			a.Config = archive.NewConfigSnapshot(cfg)
+
+			if output == "-" {
+				return archive.Write(os.Stdout, a)
+			}
+
+			f, err := os.Create(output) //nolint:gosec
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", output, err)
+			}
+
+			if err := archive.Write(f, a); err != nil {
+				_ = f.Close()
+				return err
+			}
+
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to close %s: %w", output, err)
+			}
+
+			fmt.Fprintf(os.Stderr, "exported %d tasks and %d sessions to %s\n", len(a.Tasks), len(a.History), output)
+
+			return nil
+		},
+	}
+
+	exportCmd.Flags().StringP("output", "o", "-", "file to write the archive to, - for the standard output")
+
+	return exportCmd
+}
+
+func newImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import FILE",
+		Short: "import a JSON archive written by export",
+		Long: `This command adds the data of an archive written by the export command to the configured storage,
+or reads it from the standard input if FILE is -.
+Tasks and sessions that already exist are skipped, so an archive can be imported more than once.
+The latest session is restored only if there is none yet. The configuration is not changed.
+Please stop any running gomodoro server first.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+
+			// A running server keeps the timer of the current session and would not see the imported data.
+			if err := graphql.NewClientWrapper(cfg.API).Ping(ctx); err == nil {
+				return fmt.Errorf("a gomodoro server is running on %s, please stop it before importing", cfg.API.Addr)
+			}
+
+			r := io.Reader(os.Stdin)
+
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return fmt.Errorf("failed to open %s: %w", args[0], err)
+				}
+				defer f.Close() //nolint:errcheck
+
+				r = f
+			}
+
+			a, err := archive.Read(r)
+			if err != nil {
+				return err
+			}
+
+			store, err := driver.Open(cfg.Storage)
+			if err != nil {
+				return fmt.Errorf("failed to open storage: %w", err)
+			}
+			defer closeStorage(cmd, store)
+
+			result, err := archive.Import(store, a)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("imported %d tasks (%d skipped) and %d sessions (%d skipped)\n",
+				result.Tasks, result.SkippedTasks, result.Sessions, result.SkippedSessions)
+
+			if result.Current {
+				fmt.Println("restored the latest session")
+			}
+
+			return nil
+		},
+	}
+}
+
+func closeStorage(cmd *cobra.Command, store storage.Storage) {
+	closer, ok := store.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		log.FromContext(cmd.Context()).Error(err, "Failed to close storage")
+	}
+}
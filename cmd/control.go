@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatappi/gomodoro/internal/client/graphql"
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/status"
+)
+
+// controlFunc changes the current pomodoro through the API and returns it.
+type controlFunc func(ctx context.Context, client *graphql.ClientWrapper) (*core.Pomodoro, error)
+
+// newControlCmd creates a command that controls the pomodoro of a running server
+// and prints the resulting state on one line, so that it can be bound to a key or used in scripts.
+func newControlCmd(use, short string, control controlFunc) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+
+			pomodoro, err := control(cmd.Context(), graphql.NewClientWrapper(cfg.API))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(describePomodoro(pomodoro))
+
+			return nil
+		},
+	}
+}
+
+func newPauseCmd() *cobra.Command {
+	return newControlCmd(
+		"pause",
+		"pause the running pomodoro",
+		func(ctx context.Context, c *graphql.ClientWrapper) (*core.Pomodoro, error) {
+			return c.PausePomodoro(ctx)
+		},
+	)
+}
+
+func newResumeCmd() *cobra.Command {
+	return newControlCmd(
+		"resume",
+		"resume the paused pomodoro",
+		func(ctx context.Context, c *graphql.ClientWrapper) (*core.Pomodoro, error) {
+			return c.ResumePomodoro(ctx)
+		},
+	)
+}
+
+func newToggleCmd() *cobra.Command {
+	return newControlCmd("toggle", "pause the running pomodoro or resume the paused one", togglePomodoro)
+}
+
+func newStopCmd() *cobra.Command {
+	return newControlCmd(
+		"stop",
+		"stop the current phase; the next start moves on to the following phase",
+		func(ctx context.Context, c *graphql.ClientWrapper) (*core.Pomodoro, error) {
+			return c.StopPomodoro(ctx)
+		},
+	)
+}
+
+func newResetCmd() *cobra.Command {
+	return newControlCmd(
+		"reset",
+		"stop the current pomodoro and start the next one from the first work session",
+		func(ctx context.Context, c *graphql.ClientWrapper) (*core.Pomodoro, error) {
+			return c.ResetPomodoro(ctx)
+		},
+	)
+}
+
+func togglePomodoro(ctx context.Context, c *graphql.ClientWrapper) (*core.Pomodoro, error) {
+	pomodoro, err := c.GetCurrentPomodoro(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pomodoro.State {
+	case event.PomodoroStateActive:
+		return c.PausePomodoro(ctx)
+	case event.PomodoroStatePaused:
+		return c.ResumePomodoro(ctx)
+	case event.PomodoroStateFinished:
+		return nil, fmt.Errorf("no running pomodoro")
+	default:
+		return nil, fmt.Errorf("unknown pomodoro state: %s", pomodoro.State)
+	}
+}
+
+// describePomodoro formats the state, phase and remaining time of a pomodoro, e.g. "paused work 12:34".
+func describePomodoro(pomodoro *core.Pomodoro) string {
+	if pomodoro == nil {
+		return "no pomodoro"
+	}
+
+	if pomodoro.State == event.PomodoroStateFinished {
+		return fmt.Sprintf("%s %s", pomodoro.State, pomodoro.Phase)
+	}
+
+	return fmt.Sprintf("%s %s %s", pomodoro.State, pomodoro.Phase, status.FormatRemaining(pomodoro))
+}
@@ -0,0 +1,13 @@
+//go:build !unix
+// +build !unix
+
+package cmd
+
+import (
+	"syscall"
+)
+
+// detachedSysProcAttr returns nil because only unix systems start the process in a new session.
+func detachedSysProcAttr() *syscall.SysProcAttr {
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:build unix
+// +build unix
+
+package cmd
+
+import (
+	"syscall"
+)
+
+// detachedSysProcAttr runs the process in a new session, so it outlives the terminal that started it.
+func detachedSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
@@ -17,6 +17,8 @@ const confTpl = `# pomodoro:
 #   work_sec: {{ .Pomodoro.WorkSec }}
 #   short_break_sec: {{ .Pomodoro.ShortBreakSec }}
 #   long_break_sec: {{ .Pomodoro.LongBreakSec }}
+#   # take a long break after every N work sessions (2-9)
+#   break_frequency: {{ .Pomodoro.BreakFrequency }}
 # toggl:
 #   enable: false
 #   # https://track.toggl.com/{organization_id}/projects/{project_id}/team
@@ -29,6 +31,16 @@ const confTpl = `# pomodoro:
 #
 # api:
 #  addr: localhost:8080
+#  # events queued for each subscriber, e.g. a running TUI, before event_overflow_policy applies
+#  event_buffer_size: {{ .API.EventBufferSize }}
+#  # drop_oldest, coalesce_ticks (drop pending timer ticks first) or disconnect
+#  # (disconnect applies to API clients only; webhooks, hooks and notifications coalesce ticks instead)
+#  event_overflow_policy: {{ .API.EventOverflowPolicy }}
+#
+## storage.driver is either "file" (JSON files) or "sqlite" (embedded database)
+# storage:
+#   driver: {{ .Storage.Driver }}
+#   dir: {{ .Storage.Dir }}
 #
 ## You can change the colors used within gomodoro.
 ## You need to specify W3C Color name (e.g. red) or HEX (.e.g. #ffffff)
@@ -41,6 +53,75 @@ const confTpl = `# pomodoro:
 #   timer_work_font: "green"
 #   timer_break_font: "blue"
 #   cursor: "green"
+#
+## sounds played by the TUI. press m in the timer to mute or unmute them.
+## phase_end, break_end and tick take WAV or Ogg Vorbis files. empty plays the built-in sounds.
+# sound:
+#   # volume in percent (0-100)
+#   volume: {{ .Sound.Volume }}
+#   mute: false
+#   phase_end: ~/.gomodoro/sounds/bell.ogg
+#   # played when a break ends. empty plays phase_end
+#   break_end: ~/.gomodoro/sounds/gong.wav
+#   tick: ~/.gomodoro/sounds/tick.wav
+#   # play tick every second of work
+#   tick_enable: false
+#
+## webhooks receive pomodoro and task events as JSON POST requests.
+## events defaults to every event except pomodoro.tick.
+## the body is signed with secret in the X-Gomodoro-Signature header (sha256=<hex of HMAC-SHA256>).
+## template renders a custom JSON body from .Webhook, .Type, .Timestamp, .Sequence, .Test and .Event.
+# webhooks:
+#   - name: chat
+#     urls:
+#       - https://example.com/hooks/gomodoro
+#     events:
+#       - pomodoro.completed
+#     secret: change-me
+#     template: '{"text": {{ "{{" }} json (printf "%s finished" .Event.Phase) {{ "}}" }}}'
+#     # attempts before a delivery goes to the dead-letter queue (gomodoro webhook dead-letters)
+#     max_attempts: 4
+#     timeout: 10s
+#
+## hooks run shell commands on pomodoro and task events, e.g. to toggle Do Not Disturb.
+## the event fields are passed as GOMODORO_* environment variables and the event as JSON on stdin.
+# hooks:
+#   events:
+#     pomodoro.started:
+#       - 'echo "$GOMODORO_POMODORO_PHASE started" >> ~/.gomodoro/hooks.log'
+#     pomodoro.completed:
+#       - 'echo "$GOMODORO_POMODORO_PHASE completed" >> ~/.gomodoro/hooks.log'
+#   # commands still running after timeout are killed
+#   timeout: {{ .Hooks.Timeout }}
+#   max_concurrency: {{ .Hooks.MaxConcurrency }}
+#
+## notifications are sent by the API server when a phase ends, also without the TUI.
+## channel types: desktop, bell, webhook, ntfy, gotify
+# notifications:
+#   channels:
+#     - name: desktop
+#       type: desktop
+#     - name: phone
+#       type: ntfy
+#       url: https://ntfy.sh/my-gomodoro-topic
+#       priority: 4
+#   phases:
+#     # channels lists the channels of the phase. empty notifies every channel.
+#     work:
+#       channels:
+#         - desktop
+#         - phone
+#       title: gomodoro
+#       message: '{{ "{{" }} .Task.Title {{ "}}" }}: time for a break'
+#     short_break:
+#       channels:
+#         - desktop
+#     long_break:
+#       channels:
+#         - desktop
+#       # also notify when the phase is stopped
+#       on_stop: true
+#   timeout: {{ .Notifications.Timeout }}
 `
 
 func newInitCmd() *cobra.Command {
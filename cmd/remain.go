@@ -3,18 +3,14 @@ package cmd
 
 import (
 	"fmt"
-	"slices"
 
 	"github.com/spf13/cobra"
 
 	"github.com/hatappi/gomodoro/internal/client/graphql"
 	"github.com/hatappi/gomodoro/internal/config"
-	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/status"
 )
 
-// secondsPerMinute represents the number of seconds in a minute.
-const secondsPerMinute = 60
-
 func newRemainCmd() *cobra.Command {
 	remainCmd := &cobra.Command{
 		Use:   "remain",
@@ -48,18 +44,7 @@ func newRemainCmd() *cobra.Command {
 				return nil
 			}
 
-			var remainingStr string
-			if slices.Contains([]event.PomodoroState{event.PomodoroStateActive, event.PomodoroStatePaused}, pomodoro.State) {
-				sec := int(pomodoro.RemainingTime.Seconds())
-				minutes := sec / secondsPerMinute
-				seconds := sec % secondsPerMinute
-
-				remainingStr = fmt.Sprintf("%02d:%02d", minutes, seconds)
-			} else {
-				remainingStr = "--:--"
-			}
-
-			fmt.Print(remainingStr)
+			fmt.Print(status.FormatRemaining(pomodoro))
 			return nil
 		},
 	}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/api/server"
+	"github.com/hatappi/gomodoro/internal/client/graphql"
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/report"
+)
+
+// defaultReportDays is the number of days, including today, reported when --since is omitted.
+const defaultReportDays = 7
+
+func newReportCmd() *cobra.Command {
+	reportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "report focus time",
+		Long: `This command reports the focus time of the work sessions in a period.
+--since and --until take a date (2006-01-02) or a time (RFC 3339).
+A date given to --until is included in the period.
+The period defaults to the last 7 days, including today.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			opts, err := reportOptionsFromFlags(cmd, time.Now())
+			if err != nil {
+				return err
+			}
+
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+
+			serverRunner, err := server.NewRunner(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create server runner: %w", err)
+			}
+
+			if err := serverRunner.EnsureRunning(ctx); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to ensure API server is running")
+				return fmt.Errorf("failed to ensure API server is running: %w", err)
+			}
+
+			defer func() {
+				if err := serverRunner.Stop(ctx); err != nil {
+					log.FromContext(ctx).Error(err, "Failed to stop API server")
+				}
+			}()
+
+			gqlClient := graphql.NewClientWrapper(cfg.API)
+
+			stats, err := gqlClient.GetStats(ctx, opts.since, opts.until, opts.groupBy)
+			if err != nil {
+				return err
+			}
+
+			tasks, err := gqlClient.GetAllTasks(ctx)
+			if err != nil {
+				return err
+			}
+
+			r := &report.Report{
+				Since:      opts.since,
+				Until:      opts.until,
+				GroupBy:    opts.groupBy,
+				Stats:      stats,
+				TaskTitles: make(map[string]string, len(tasks)),
+			}
+
+			for _, task := range tasks {
+				r.TaskTitles[task.ID] = task.Title
+			}
+
+			if opts.sessions {
+				history, err := gqlClient.GetPomodoroHistory(ctx, opts.since, opts.until)
+				if err != nil {
+					return err
+				}
+
+				r.Sessions = make([]*core.Pomodoro, 0, len(history))
+				for _, p := range history {
+					if p.Phase == event.PomodoroPhaseWork {
+						r.Sessions = append(r.Sessions, p)
+					}
+				}
+			}
+
+			return r.Write(os.Stdout, opts.format)
+		},
+	}
+
+	formats := make([]string, len(report.Formats))
+	for i, f := range report.Formats {
+		formats[i] = string(f)
+	}
+
+	reportCmd.Flags().String("since", "", "start of the period (default 6 days before today)")
+	reportCmd.Flags().String("until", "", "end of the period (default today)")
+	reportCmd.Flags().StringP("group-by", "g", string(core.StatsGroupByDay), "group by day, week, task, project or tag")
+	reportCmd.Flags().StringP("format", "f", string(report.FormatTable), "output format ("+strings.Join(formats, ", ")+")")
+	reportCmd.Flags().BoolP("sessions", "s", false, "list the work sessions as well")
+
+	return reportCmd
+}
+
+type reportOptions struct {
+	since    time.Time
+	until    time.Time
+	groupBy  core.StatsGroupBy
+	format   report.Format
+	sessions bool
+}
+
+func reportOptionsFromFlags(cmd *cobra.Command, now time.Time) (reportOptions, error) {
+	var opts reportOptions
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return opts, fmt.Errorf("failed to get since flag: %w", err)
+	}
+
+	opts.since = today.AddDate(0, 0, 1-defaultReportDays)
+	if since != "" {
+		if opts.since, _, err = parseReportTime(since, now.Location()); err != nil {
+			return opts, fmt.Errorf("invalid --since: %w", err)
+		}
+	}
+
+	until, err := cmd.Flags().GetString("until")
+	if err != nil {
+		return opts, fmt.Errorf("failed to get until flag: %w", err)
+	}
+
+	opts.until = today.AddDate(0, 0, 1)
+	if until != "" {
+		var isDate bool
+		if opts.until, isDate, err = parseReportTime(until, now.Location()); err != nil {
+			return opts, fmt.Errorf("invalid --until: %w", err)
+		}
+
+		if isDate {
+			opts.until = opts.until.AddDate(0, 0, 1)
+		}
+	}
+
+	if !opts.since.Before(opts.until) {
+		return opts, fmt.Errorf("--since must be before --until")
+	}
+
+	groupBy, err := cmd.Flags().GetString("group-by")
+	if err != nil {
+		return opts, fmt.Errorf("failed to get group-by flag: %w", err)
+	}
+
+	if opts.groupBy, err = core.ParseStatsGroupBy(groupBy); err != nil {
+		return opts, err
+	}
+
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return opts, fmt.Errorf("failed to get format flag: %w", err)
+	}
+
+	if opts.format, err = report.ParseFormat(format); err != nil {
+		return opts, err
+	}
+
+	if opts.sessions, err = cmd.Flags().GetBool("sessions"); err != nil {
+		return opts, fmt.Errorf("failed to get sessions flag: %w", err)
+	}
+
+	return opts, nil
+}
+
+// parseReportTime parses a date in loc or an RFC 3339 time, and reports whether it was a date.
+func parseReportTime(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, s, loc); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("expected a date (2006-01-02) or an RFC 3339 time: %s", s)
+	}
+
+	return t, false, nil
+}
@@ -39,6 +39,17 @@ func Execute() {
 		newInitCmd(),
 		newAddTaskCmd(),
 		newServeCmd(),
+		newReportCmd(),
+		newPauseCmd(),
+		newResumeCmd(),
+		newToggleCmd(),
+		newStopCmd(),
+		newResetCmd(),
+		newStatusCmd(),
+		newTaskCmd(),
+		newExportCmd(),
+		newImportCmd(),
+		newWebhookCmd(),
 	)
 
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
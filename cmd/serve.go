@@ -30,7 +30,10 @@ func newServeCmd() *cobra.Command {
 				return fmt.Errorf("failed to get config: %w", err)
 			}
 
-			serverRunner := server.NewRunner(cfg)
+			serverRunner, err := server.NewRunner(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create server runner: %w", err)
+			}
 
 			if err := serverRunner.Start(ctx); err != nil {
 				return fmt.Errorf("failed to start server runner: %w", err)
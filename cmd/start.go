@@ -3,8 +3,14 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,19 +19,44 @@ import (
 
 	"github.com/hatappi/gomodoro/internal/api/server"
 	"github.com/hatappi/gomodoro/internal/client/graphql"
+	gqlgen "github.com/hatappi/gomodoro/internal/client/graphql/generated"
 	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
 	gomodoro_error "github.com/hatappi/gomodoro/internal/errors"
+	"github.com/hatappi/gomodoro/internal/sound"
 	"github.com/hatappi/gomodoro/internal/tui"
 )
 
+const (
+	// detachedServerTimeout is how long to wait for a server started in the background to answer.
+	detachedServerTimeout = 5 * time.Second
+
+	// detachedServerPollInterval is how often the server started in the background is checked.
+	detachedServerPollInterval = 100 * time.Millisecond
+)
+
+// startOutput is the format a headless start prints the session in.
+type startOutput string
+
+const (
+	startOutputText startOutput = "text"
+	startOutputJSON startOutput = "json"
+)
+
 // startCmd represents the start command.
 func newStartCmd() *cobra.Command {
 	startCmd := &cobra.Command{
 		Use:   "start",
 		Short: "start pomodoro",
 		Long: `start pomodoro.
-if you want to change work time, break time,
+if you want to change work time, break time or how often a long break comes,
 please specify argument or config yaml.
+The timer shows which work session of the long break cycle you are in (e.g. 2/4).
+
+With --task or --detach, the session starts without the TUI and the phases advance on their own.
+--task takes the ID or title of a task; a task with an unknown title is created.
+--detach starts the API server in the background if it is not running and returns immediately.
+Without --detach, the command keeps serving until interrupted if no server was running.
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			ctx := cmd.Context()
@@ -35,7 +66,19 @@ please specify argument or config yaml.
 				return fmt.Errorf("failed to get config: %w", err)
 			}
 
-			serverRunner := server.NewRunner(cfg)
+			opts, err := headlessOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			if opts.detach || opts.task != "" {
+				return runHeadless(ctx, cfg, opts)
+			}
+
+			serverRunner, err := server.NewRunner(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create server runner: %w", err)
+			}
 
 			if err := serverRunner.EnsureRunning(ctx); err != nil {
 				return fmt.Errorf("failed to ensure API server is running: %w", err)
@@ -60,9 +103,232 @@ please specify argument or config yaml.
 	startCmd.Flags().IntP("long-break-sec", "l", config.DefaultLongBreakSec, "long break seconds")
 	_ = viper.BindPFlag("pomodoro.long_break_sec", startCmd.Flags().Lookup("long-break-sec"))
 
+	startCmd.Flags().IntP("break-frequency", "f", config.DefaultBreakFrequency, "number of work sessions between long breaks")
+	_ = viper.BindPFlag("pomodoro.break_frequency", startCmd.Flags().Lookup("break-frequency"))
+
+	startCmd.Flags().StringP("task", "t", "", "ID or title of the task to work on, without the TUI")
+	startCmd.Flags().BoolP("detach", "d", false, "start without the TUI and return once the session has started")
+	startCmd.Flags().StringP("output", "o", string(startOutputText), "output format without the TUI (text, json)")
+
 	return startCmd
 }
 
+type headlessOptions struct {
+	task   string
+	detach bool
+	output startOutput
+}
+
+func headlessOptionsFromFlags(cmd *cobra.Command) (headlessOptions, error) {
+	var (
+		opts headlessOptions
+		err  error
+	)
+
+	if opts.task, err = cmd.Flags().GetString("task"); err != nil {
+		return opts, fmt.Errorf("failed to get task flag: %w", err)
+	}
+
+	if opts.detach, err = cmd.Flags().GetBool("detach"); err != nil {
+		return opts, fmt.Errorf("failed to get detach flag: %w", err)
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return opts, fmt.Errorf("failed to get output flag: %w", err)
+	}
+
+	switch opts.output = startOutput(output); opts.output {
+	case startOutputText, startOutputJSON:
+	default:
+		return opts, fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return opts, nil
+}
+
+// runHeadless starts an auto-advancing session through the API and prints it.
+func runHeadless(ctx context.Context, cfg *config.Config, opts headlessOptions) error {
+	gqlClient := graphql.NewClientWrapper(cfg.API)
+
+	var serverRunner *server.Runner
+
+	if opts.detach {
+		if err := ensureDetachedServer(ctx, cfg, gqlClient); err != nil {
+			return err
+		}
+	} else {
+		var err error
+
+		serverRunner, err = server.NewRunner(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create server runner: %w", err)
+		}
+
+		if err := serverRunner.EnsureRunning(ctx); err != nil {
+			return fmt.Errorf("failed to ensure API server is running: %w", err)
+		}
+
+		defer func() {
+			if err := serverRunner.Stop(ctx); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to stop API server")
+			}
+		}()
+	}
+
+	var taskID string
+
+	if opts.task != "" {
+		task, err := findOrCreateTask(ctx, gqlClient, opts.task)
+		if err != nil {
+			return err
+		}
+
+		taskID = task.ID
+	}
+
+	pomodoro, err := gqlClient.StartPomodoro(ctx, gqlgen.StartPomodoroInput{
+		WorkDurationSec:      cfg.Pomodoro.WorkSec,
+		BreakDurationSec:     cfg.Pomodoro.ShortBreakSec,
+		LongBreakDurationSec: cfg.Pomodoro.LongBreakSec,
+		BreakFrequency:       cfg.Pomodoro.BreakFrequency,
+		TaskId:               taskID,
+		AutoAdvance:          true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := printStartedPomodoro(pomodoro, opts.output); err != nil {
+		return err
+	}
+
+	// A server running in this process stops with it, so keep it serving the session.
+	if serverRunner != nil && serverRunner.IsRunning() {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		<-ctx.Done()
+	}
+
+	return nil
+}
+
+// ensureDetachedServer starts the API server as a background process unless one is already running,
+// and waits until it answers.
+func ensureDetachedServer(ctx context.Context, cfg *config.Config, gqlClient *graphql.ClientWrapper) error {
+	if err := gqlClient.Ping(ctx); err == nil {
+		return nil
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get executable: %w", err)
+	}
+
+	//nolint:gosec
+	serveCmd := exec.Command(executable, "serve",
+		"--config", cfgFile,
+		"--log-file", cfg.LogFile,
+		"--log-level", cfg.LogLevel.String(),
+	)
+	serveCmd.SysProcAttr = detachedSysProcAttr()
+
+	if err := serveCmd.Start(); err != nil {
+		return fmt.Errorf("failed to start API server in the background: %w", err)
+	}
+
+	if err := serveCmd.Process.Release(); err != nil {
+		return fmt.Errorf("failed to release API server process: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, detachedServerTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(detachedServerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("API server did not start in the background: %w", ctx.Err())
+		case <-ticker.C:
+			if err := gqlClient.Ping(ctx); err == nil {
+				return nil
+			}
+		}
+	}
+}
+
+// findOrCreateTask returns the task with the given ID or title, creating a task with that title if none matches.
+func findOrCreateTask(ctx context.Context, gqlClient *graphql.ClientWrapper, idOrTitle string) (*core.Task, error) {
+	tasks, err := gqlClient.GetAllTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		if task.ID == idOrTitle {
+			return task, nil
+		}
+	}
+
+	for _, task := range tasks {
+		if task.Title == idOrTitle {
+			return task, nil
+		}
+	}
+
+	return gqlClient.CreateTask(ctx, idOrTitle, core.TaskDetails{})
+}
+
+func printStartedPomodoro(pomodoro *core.Pomodoro, output startOutput) error {
+	if output == startOutputText {
+		fmt.Println(describePomodoro(pomodoro))
+		return nil
+	}
+
+	b, err := json.MarshalIndent(newPomodoroJSON(pomodoro), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal pomodoro: %w", err)
+	}
+
+	fmt.Println(string(b))
+
+	return nil
+}
+
+// pomodoroJSON is the JSON representation of a session printed by the CLI.
+type pomodoroJSON struct {
+	ID               string    `json:"id"`
+	State            string    `json:"state"`
+	Phase            string    `json:"phase"`
+	PhaseCount       int       `json:"phase_count"`
+	TaskID           string    `json:"task_id,omitempty"`
+	StartTime        time.Time `json:"start_time"`
+	RemainingTimeSec int       `json:"remaining_time_sec"`
+	ElapsedTimeSec   int       `json:"elapsed_time_sec"`
+	PhaseDurationSec int       `json:"phase_duration_sec"`
+	BreakFrequency   int       `json:"break_frequency"`
+	AutoAdvance      bool      `json:"auto_advance"`
+}
+
+func newPomodoroJSON(pomodoro *core.Pomodoro) pomodoroJSON {
+	return pomodoroJSON{
+		ID:               pomodoro.ID,
+		State:            string(pomodoro.State),
+		Phase:            string(pomodoro.Phase),
+		PhaseCount:       pomodoro.PhaseCount,
+		TaskID:           pomodoro.TaskID,
+		StartTime:        pomodoro.StartTime,
+		RemainingTimeSec: int(pomodoro.RemainingTime.Seconds()),
+		ElapsedTimeSec:   int(pomodoro.ElapsedTime.Seconds()),
+		PhaseDurationSec: int(pomodoro.PhaseDuration.Seconds()),
+		BreakFrequency:   pomodoro.BreakFrequency,
+		AutoAdvance:      pomodoro.AutoAdvance,
+	}
+}
+
 func runTUIApp(ctx context.Context, cfg *config.Config) error {
 	gqlClient := graphql.NewClientWrapper(cfg.API)
 	defer func() {
@@ -75,11 +341,23 @@ func runTUIApp(ctx context.Context, cfg *config.Config) error {
 		tui.WithWorkSec(cfg.Pomodoro.WorkSec),
 		tui.WithShortBreakSec(cfg.Pomodoro.ShortBreakSec),
 		tui.WithLongBreakSec(cfg.Pomodoro.LongBreakSec),
-		tui.WithNotify(),
+		tui.WithBreakFrequency(cfg.Pomodoro.BreakFrequency),
+	}
+
+	// The desktop notification channel of the server notifies instead, without the buttons of the TUI.
+	if !cfg.Notifications.HasChannel(config.NotificationChannelDesktop) {
+		opts = append(opts, tui.WithNotify())
+	}
+
+	player, err := sound.New(cfg.Sound)
+	if err != nil {
+		return fmt.Errorf("failed to load sounds: %w", err)
 	}
+	opts = append(opts, tui.WithSound(player))
 
 	app, err := tui.NewApp(cfg, gqlClient, opts...)
 	if err != nil {
+		_ = player.Close()
 		return fmt.Errorf("failed to create TUI App: %w", err)
 	}
 	defer app.Finish(ctx)
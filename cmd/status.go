@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/client/graphql"
+	gqlgen "github.com/hatappi/gomodoro/internal/client/graphql/generated"
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/status"
+)
+
+func newStatusCmd() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "print the current pomodoro on one line",
+		Long: `This command prints the current pomodoro on one line for status bars and shell prompts.
+--format is a Go text/template executed with the following fields:
+
+  {{.State}}           active, paused or finished
+  {{.Phase}}           work, short_break or long_break
+  {{.PhaseCount}}      number of the phase since the cycle started
+  {{.Cycle}}           work session within the long break cycle
+  {{.BreakFrequency}}  number of work sessions between long breaks
+  {{.Remaining}}       remaining time as mm:ss, or --:-- when nothing runs
+  {{.RemainingSec}}    remaining seconds
+  {{.ElapsedSec}}      elapsed seconds
+  {{.Progress}}        elapsed part of the phase in percent
+  {{.TaskID}}          ID of the task
+  {{.TaskTitle}}       title of the task
+
+With --watch, a line is printed whenever the status changes, using the events of the server.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return fmt.Errorf("failed to get format flag: %w", err)
+			}
+
+			watch, err := cmd.Flags().GetBool("watch")
+			if err != nil {
+				return fmt.Errorf("failed to get watch flag: %w", err)
+			}
+
+			ignoreError, err := cmd.Flags().GetBool("ignore-error")
+			if err != nil {
+				return fmt.Errorf("failed to get ignore-error flag: %w", err)
+			}
+
+			tmpl, err := status.Parse(format)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+
+			gqlClient := graphql.NewClientWrapper(cfg.API)
+
+			pomodoro, task, err := gqlClient.GetCurrentStatus(ctx)
+			if err != nil {
+				if !ignoreError {
+					return err
+				}
+
+				pomodoro, task = nil, nil
+			}
+
+			w := &statusWriter{tmpl: tmpl}
+			if err := w.write(status.New(pomodoro, task)); err != nil {
+				return err
+			}
+
+			if !watch {
+				return nil
+			}
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return watchStatus(ctx, gqlClient, w, pomodoro, task)
+		},
+	}
+
+	statusCmd.Flags().StringP("format", "f", status.DefaultFormat, "Go template of the line to print")
+	statusCmd.Flags().BoolP("watch", "w", false, "print a new line whenever the status changes")
+	statusCmd.Flags().BoolP("ignore-error", "i", false, "print the status without a pomodoro instead of failing")
+
+	return statusCmd
+}
+
+// statusWriter prints the rendered status, skipping lines equal to the previous one.
+type statusWriter struct {
+	tmpl *status.Template
+	last string
+	seen bool
+}
+
+func (w *statusWriter) write(s status.Status) error {
+	line, err := w.tmpl.Render(s)
+	if err != nil {
+		return err
+	}
+
+	if w.seen && line == w.last {
+		return nil
+	}
+
+	w.last, w.seen = line, true
+
+	fmt.Println(line)
+
+	return nil
+}
+
+// watchStatus prints the status each time a pomodoro or task event changes it, until ctx is done.
+func watchStatus(
+	ctx context.Context,
+	gqlClient *graphql.ClientWrapper,
+	w *statusWriter,
+	pomodoro *core.Pomodoro,
+	task *core.Task,
+) error {
+	connErrChan, err := gqlClient.ConnectSubscription(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := gqlClient.DisconnectSubscription(); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to disconnect subscription")
+		}
+	}()
+
+	eventChan, errChan, _, err := gqlClient.SubscribeToEvents(ctx, gqlgen.EventReceivedInput{
+		EventCategory: []gqlgen.EventCategory{gqlgen.EventCategoryPomodoro, gqlgen.EventCategoryTask},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-connErrChan:
+			if !ok {
+				connErrChan = nil
+				continue
+			}
+
+			return fmt.Errorf("subscription connection failed: %w", err)
+		case err, ok := <-errChan:
+			if !ok {
+				errChan = nil
+				continue
+			}
+
+			return err
+		case e, ok := <-eventChan:
+			if !ok {
+				return fmt.Errorf("event subscription closed")
+			}
+
+			switch e := e.(type) {
+			case event.PomodoroEvent:
+				if task == nil || task.ID != e.TaskID {
+					task = lookupTask(ctx, gqlClient, e.TaskID)
+				}
+
+				pomodoro = pomodoroFromEvent(e)
+			case event.TaskEvent:
+				if task == nil || task.ID != e.ID {
+					continue
+				}
+
+				if e.Type == event.TaskDeleted {
+					task = nil
+				} else {
+					task = &core.Task{ID: e.ID, Title: e.Title}
+				}
+			default:
+				continue
+			}
+
+			if err := w.write(status.New(pomodoro, task)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// lookupTask returns the task with the given ID, or nil if there is none or it cannot be fetched.
+func lookupTask(ctx context.Context, gqlClient *graphql.ClientWrapper, id string) *core.Task {
+	if id == "" {
+		return nil
+	}
+
+	task, err := gqlClient.GetTask(ctx, id)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to get task", "id", id)
+		return nil
+	}
+
+	return task
+}
+
+func pomodoroFromEvent(e event.PomodoroEvent) *core.Pomodoro {
+	return &core.Pomodoro{
+		ID:             e.ID,
+		State:          e.State,
+		RemainingTime:  e.RemainingTime,
+		ElapsedTime:    e.ElapsedTime,
+		Phase:          e.Phase,
+		PhaseCount:     e.PhaseCount,
+		PhaseDuration:  e.PhaseDuration,
+		BreakFrequency: e.BreakFrequency,
+		TaskID:         e.TaskID,
+	}
+}
@@ -0,0 +1,568 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/api/server"
+	"github.com/hatappi/gomodoro/internal/client/graphql"
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/editor"
+	"github.com/hatappi/gomodoro/internal/taskedit"
+	"github.com/hatappi/gomodoro/internal/taskimport"
+)
+
+// taskStatuses lists the task statuses accepted by --status.
+var taskStatuses = []event.TaskStatus{
+	event.TaskStatusTodo,
+	event.TaskStatusInProgress,
+	event.TaskStatusDone,
+	event.TaskStatusArchived,
+}
+
+func newTaskCmd() *cobra.Command {
+	taskCmd := &cobra.Command{
+		Use:   "task",
+		Short: "manage tasks",
+	}
+
+	taskCmd.AddCommand(
+		newTaskListCmd(),
+		newTaskShowCmd(),
+		newTaskRenameCmd(),
+		newTaskRmCmd(),
+		newTaskDoneCmd(),
+		newTaskEditCmd(),
+		newTaskImportCmd(),
+	)
+
+	return taskCmd
+}
+
+func newTaskListCmd() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "list tasks",
+		Long: `This command lists the tasks, oldest first.
+Archived tasks are listed only when --status asks for them.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter, err := taskFilterFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return fmt.Errorf("failed to get format flag: %w", err)
+			}
+
+			if format != "table" && format != "json" {
+				return fmt.Errorf("unknown format: %s", format)
+			}
+
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				tasks, err := gqlClient.ListTasks(ctx, filter)
+				if err != nil {
+					return err
+				}
+
+				if format == "json" {
+					return writeJSON(os.Stdout, tasks)
+				}
+
+				return writeTaskTable(os.Stdout, tasks)
+			})
+		},
+	}
+
+	listCmd.Flags().StringSliceP("status", "s", []string{"todo", "in_progress", "done"}, "statuses to list")
+	listCmd.Flags().StringP("tag", "t", "", "list only the tasks with the tag")
+	listCmd.Flags().StringP("query", "q", "", "list only the tasks whose title contains the text")
+	listCmd.Flags().StringP("format", "f", "table", "output format (table, json)")
+
+	return listCmd
+}
+
+func newTaskShowCmd() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show ID",
+		Short: "show a task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				task, err := gqlClient.GetTask(ctx, args[0])
+				if err != nil {
+					return err
+				}
+
+				if jsonOutput {
+					return writeJSON(os.Stdout, task)
+				}
+
+				return writeTask(os.Stdout, task)
+			})
+		},
+	}
+
+	showCmd.Flags().Bool("json", false, "print the task as JSON")
+
+	return showCmd
+}
+
+func newTaskRenameCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rename ID TITLE",
+		Short: "rename a task",
+		Args:  cobra.MinimumNArgs(2), //nolint:mnd
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title := strings.Join(args[1:], " ")
+
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				task, err := gqlClient.UpdateTask(ctx, args[0], core.TaskUpdate{Title: &title})
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("renamed task '%s' to '%s'\n", task.ID, task.Title)
+
+				return nil
+			})
+		},
+	}
+}
+
+func newTaskRmCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rm ID...",
+		Short: "delete tasks",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				for _, id := range args {
+					if err := gqlClient.DeleteTask(ctx, id); err != nil {
+						return fmt.Errorf("failed to delete task '%s': %w", id, err)
+					}
+
+					fmt.Printf("deleted task '%s'\n", id)
+				}
+
+				return nil
+			})
+		},
+	}
+}
+
+func newTaskDoneCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "done ID...",
+		Short: "mark tasks as done",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				for _, id := range args {
+					task, err := gqlClient.CompleteTask(ctx, id)
+					if err != nil {
+						return fmt.Errorf("failed to complete task '%s': %w", id, err)
+					}
+
+					fmt.Printf("completed task '%s'\n", task.Title)
+				}
+
+				return nil
+			})
+		},
+	}
+}
+
+func newTaskEditCmd() *cobra.Command {
+	editCmd := &cobra.Command{
+		Use:   "edit",
+		Short: "edit the tasks in the editor",
+		Long: `This command opens $EDITOR with every task on its own line.
+Renaming a line renames the task, removing a line deletes the task,
+and a line added without an ID creates a task.
+
+Deleting tasks has to be confirmed unless --yes is given,
+and saving a text without any task lines aborts the edit.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			yes, err := cmd.Flags().GetBool("yes")
+			if err != nil {
+				return fmt.Errorf("failed to get yes flag: %w", err)
+			}
+
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				tasks, err := gqlClient.GetAllTasks(ctx)
+				if err != nil {
+					return err
+				}
+
+				lines, err := editor.ContentsByLine(taskedit.Format(tasks))
+				if err != nil {
+					return err
+				}
+
+				diff, err := taskedit.Parse(lines, tasks)
+				if err != nil {
+					return err
+				}
+
+				if diff.IsEmpty() {
+					fmt.Println("no changes")
+					return nil
+				}
+
+				if len(diff.Delete) > 0 && !yes {
+					ok, err := confirmTaskDeletion(cmd.InOrStdin(), tasks, diff.Delete)
+					if err != nil {
+						return err
+					}
+
+					if !ok {
+						fmt.Println("aborted, nothing was changed")
+						return nil
+					}
+				}
+
+				return applyTaskDiff(ctx, gqlClient, diff)
+			})
+		},
+	}
+
+	editCmd.Flags().BoolP("yes", "y", false, "delete the tasks of removed lines without confirmation")
+
+	return editCmd
+}
+
+func newTaskImportCmd() *cobra.Command {
+	formats := make([]string, len(taskimport.Formats))
+	for i, f := range taskimport.Formats {
+		formats[i] = string(f)
+	}
+
+	importCmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "import tasks from a Markdown checklist, todo.txt or CSV file",
+		Long: `This command creates the tasks listed in a file, or in the standard input if FILE is -.
+
+markdown: "- [ ] title" items. The nearest heading becomes the project, #words become tags,
+          and checked items are imported as done.
+todotxt:  +project becomes the project, @context and (A) priorities become tags,
+          key:value pairs go to the notes, and "x " lines are imported as done.
+csv:      a header row names the columns title, project, tags, estimate, notes and status.
+
+The format is guessed from the .md, .txt or .csv extension when --format is omitted.
+Tasks whose title, ignoring case, is already taken are skipped.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formatName, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return fmt.Errorf("failed to get format flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			tasks, err := readImportFile(args[0], formatName)
+			if err != nil {
+				return err
+			}
+
+			return withServer(cmd.Context(), func(ctx context.Context, gqlClient *graphql.ClientWrapper) error {
+				existing, err := gqlClient.GetAllTasks(ctx)
+				if err != nil {
+					return err
+				}
+
+				fresh, duplicates := taskimport.Dedupe(tasks, existing)
+
+				for _, task := range duplicates {
+					fmt.Printf("skipped duplicate task '%s'\n", task.Title)
+				}
+
+				if dryRun {
+					for _, task := range fresh {
+						fmt.Printf("would add task '%s'%s\n", task.Title, describeImportedTask(task))
+					}
+
+					return nil
+				}
+
+				for _, task := range fresh {
+					created, err := gqlClient.CreateTask(ctx, task.Title, task.Details)
+					if err != nil {
+						return fmt.Errorf("failed to create task '%s': %w", task.Title, err)
+					}
+
+					if task.Status != "" {
+						if _, err := gqlClient.UpdateTaskStatus(ctx, created.ID, task.Status); err != nil {
+							return fmt.Errorf("failed to update status of task '%s': %w", task.Title, err)
+						}
+					}
+
+					fmt.Printf("added task '%s' with ID '%s'\n", created.Title, created.ID)
+				}
+
+				return nil
+			})
+		},
+	}
+
+	importCmd.Flags().StringP("format", "f", "", "format of the file ("+strings.Join(formats, ", ")+")")
+	importCmd.Flags().BoolP("dry-run", "n", false, "print the tasks to add without adding them")
+
+	return importCmd
+}
+
+// importFormatsByExt maps file extensions to the import format guessed for them.
+var importFormatsByExt = map[string]taskimport.Format{
+	".md":       taskimport.FormatMarkdown,
+	".markdown": taskimport.FormatMarkdown,
+	".txt":      taskimport.FormatTodoTxt,
+	".csv":      taskimport.FormatCSV,
+}
+
+func readImportFile(path, formatName string) ([]taskimport.Task, error) {
+	var (
+		format taskimport.Format
+		err    error
+	)
+
+	if formatName != "" {
+		if format, err = taskimport.ParseFormat(formatName); err != nil {
+			return nil, err
+		}
+	} else {
+		var ok bool
+		if format, ok = importFormatsByExt[strings.ToLower(filepath.Ext(path))]; !ok {
+			return nil, fmt.Errorf("cannot guess the format of %s, please specify --format", path)
+		}
+	}
+
+	r := io.Reader(os.Stdin)
+
+	if path != "-" {
+		f, err := os.Open(path) //nolint:gosec
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", path, err)
+		}
+		defer f.Close() //nolint:errcheck
+
+		r = f
+	}
+
+	return taskimport.Parse(r, format)
+}
+
+// describeImportedTask formats the metadata and status of a task to import, e.g. " (project: gomodoro, done)".
+func describeImportedTask(task taskimport.Task) string {
+	var parts []string
+
+	if task.Details.Project != "" {
+		parts = append(parts, "project: "+task.Details.Project)
+	}
+
+	if len(task.Details.Tags) > 0 {
+		parts = append(parts, "tags: "+strings.Join(task.Details.Tags, ", "))
+	}
+
+	if task.Details.EstimatedPomodoros > 0 {
+		parts = append(parts, fmt.Sprintf("estimate: %d", task.Details.EstimatedPomodoros))
+	}
+
+	if task.Status != "" {
+		parts = append(parts, string(task.Status))
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+// confirmTaskDeletion lists the tasks to delete and asks to go ahead. Anything but y or yes declines.
+func confirmTaskDeletion(r io.Reader, tasks []*core.Task, ids []string) (bool, error) {
+	for _, task := range tasks {
+		if slices.Contains(ids, task.ID) {
+			fmt.Printf("delete task '%s' (%s)\n", task.Title, task.ID)
+		}
+	}
+
+	fmt.Printf("Delete %d task(s)? [y/N] ", len(ids))
+
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read answer: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// applyTaskDiff creates, renames and deletes tasks in that order, stopping at the first failure.
+func applyTaskDiff(ctx context.Context, gqlClient *graphql.ClientWrapper, diff *taskedit.Diff) error {
+	for _, title := range diff.Create {
+		task, err := gqlClient.CreateTask(ctx, title, core.TaskDetails{})
+		if err != nil {
+			return fmt.Errorf("failed to create task '%s': %w", title, err)
+		}
+
+		fmt.Printf("added task '%s' with ID '%s'\n", task.Title, task.ID)
+	}
+
+	for _, rename := range diff.Rename {
+		if _, err := gqlClient.UpdateTask(ctx, rename.ID, core.TaskUpdate{Title: &rename.Title}); err != nil {
+			return fmt.Errorf("failed to rename task '%s': %w", rename.ID, err)
+		}
+
+		fmt.Printf("renamed task '%s' to '%s'\n", rename.ID, rename.Title)
+	}
+
+	for _, id := range diff.Delete {
+		if err := gqlClient.DeleteTask(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete task '%s': %w", id, err)
+		}
+
+		fmt.Printf("deleted task '%s'\n", id)
+	}
+
+	return nil
+}
+
+// withServer ensures that the API server is running while fn talks to it.
+func withServer(ctx context.Context, fn func(context.Context, *graphql.ClientWrapper) error) error {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	serverRunner, err := server.NewRunner(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create server runner: %w", err)
+	}
+
+	if err := serverRunner.EnsureRunning(ctx); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to ensure API server is running")
+		return fmt.Errorf("failed to ensure API server is running: %w", err)
+	}
+
+	defer func() {
+		if err := serverRunner.Stop(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to stop API server")
+		}
+	}()
+
+	return fn(ctx, graphql.NewClientWrapper(cfg.API))
+}
+
+func taskFilterFromFlags(cmd *cobra.Command) (graphql.TaskFilter, error) {
+	var filter graphql.TaskFilter
+
+	statuses, err := cmd.Flags().GetStringSlice("status")
+	if err != nil {
+		return filter, fmt.Errorf("failed to get status flag: %w", err)
+	}
+
+	for _, s := range statuses {
+		status := event.TaskStatus(s)
+		if !slices.Contains(taskStatuses, status) {
+			return filter, fmt.Errorf("unknown task status: %s", s)
+		}
+
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	if filter.Tag, err = cmd.Flags().GetString("tag"); err != nil {
+		return filter, fmt.Errorf("failed to get tag flag: %w", err)
+	}
+
+	if filter.TitleContains, err = cmd.Flags().GetString("query"); err != nil {
+		return filter, fmt.Errorf("failed to get query flag: %w", err)
+	}
+
+	return filter, nil
+}
+
+func writeTaskTable(w io.Writer, tasks []*core.Task) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintln(tw, "ID\tSTATUS\tTITLE\tPROJECT\tTAGS\tESTIMATE")
+
+	for _, task := range tasks {
+		estimate := "-"
+		if task.EstimatedPomodoros > 0 {
+			estimate = fmt.Sprint(task.EstimatedPomodoros)
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			task.ID, task.Status, task.Title, task.Project, strings.Join(task.Tags, ","), estimate)
+	}
+
+	return tw.Flush()
+}
+
+func writeTask(w io.Writer, task *core.Task) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+
+	fmt.Fprintf(tw, "ID:\t%s\n", task.ID)
+	fmt.Fprintf(tw, "Title:\t%s\n", task.Title)
+	fmt.Fprintf(tw, "Status:\t%s\n", task.Status)
+	fmt.Fprintf(tw, "Project:\t%s\n", task.Project)
+	fmt.Fprintf(tw, "Tags:\t%s\n", strings.Join(task.Tags, ", "))
+	fmt.Fprintf(tw, "Estimate:\t%d\n", task.EstimatedPomodoros)
+	fmt.Fprintf(tw, "Created:\t%s\n", task.CreatedAt.Local().Format(time.DateTime))
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if task.Notes != "" {
+		fmt.Fprintf(w, "\n%s\n", task.Notes)
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/storage/driver"
+	"github.com/hatappi/gomodoro/internal/webhook"
+)
+
+func newWebhookCmd() *cobra.Command {
+	webhookCmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "manage the webhooks configured in the config file",
+	}
+
+	webhookCmd.AddCommand(
+		newWebhookTestCmd(),
+		newWebhookDeadLettersCmd(),
+	)
+
+	return webhookCmd
+}
+
+func newWebhookTestCmd() *cobra.Command {
+	testCmd := &cobra.Command{
+		Use:   "test [NAME...]",
+		Short: "send a sample event to webhooks",
+		Long: `This command sends a sample event to every URL of the named webhooks, or of all webhooks.
+The payload is rendered and signed in the same way as for real events, with "test": true in the default payload.
+Each URL gets a single attempt and failures are not added to the dead-letter queue.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			eventType, err := cmd.Flags().GetString("event")
+			if err != nil {
+				return fmt.Errorf("failed to get event flag: %w", err)
+			}
+
+			if !slices.Contains(event.AllEventTypes, event.EventType(eventType)) {
+				return fmt.Errorf("unknown event type %q", eventType)
+			}
+
+			webhooks, err := configuredWebhooks(args)
+			if err != nil {
+				return err
+			}
+
+			dispatcher := webhook.NewDispatcher(webhooks, nil)
+			sample := webhook.SampleEvent(event.EventType(eventType), time.Now())
+
+			var failed, sent int
+
+			for _, w := range webhooks {
+				body, err := w.Render(sample, true)
+				if err != nil {
+					return fmt.Errorf("failed to render the payload of webhook %q: %w", w.Name, err)
+				}
+
+				for _, url := range w.URLs {
+					sent++
+
+					err := dispatcher.Send(cmd.Context(), w, url, uuid.NewString(), sample.GetEventType(), body)
+					if err != nil {
+						failed++
+						fmt.Printf("%s %s: %s\n", w.Name, url, err)
+						continue
+					}
+
+					fmt.Printf("%s %s: ok\n", w.Name, url)
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d deliveries failed", failed, sent)
+			}
+
+			return nil
+		},
+	}
+
+	testCmd.Flags().StringP("event", "e", string(event.PomodoroCompleted), "type of the sample event")
+
+	return testCmd
+}
+
+func newWebhookDeadLettersCmd() *cobra.Command {
+	deadLettersCmd := &cobra.Command{
+		Use:     "dead-letters",
+		Aliases: []string{"dlq"},
+		Short:   "list the webhook deliveries that failed after all attempts",
+		Long: `This command lists the webhook deliveries in the dead-letter queue.
+With --retry, each of them is sent once more with the current secret of its webhook
+and removed from the queue if it succeeds. With --purge, the queue is emptied.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			retry, err := cmd.Flags().GetBool("retry")
+			if err != nil {
+				return fmt.Errorf("failed to get retry flag: %w", err)
+			}
+
+			purge, err := cmd.Flags().GetBool("purge")
+			if err != nil {
+				return fmt.Errorf("failed to get purge flag: %w", err)
+			}
+
+			if retry && purge {
+				return fmt.Errorf("--retry and --purge cannot be used together")
+			}
+
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get config: %w", err)
+			}
+
+			webhooks, err := webhook.New(cfg.Webhooks)
+			if err != nil {
+				return fmt.Errorf("failed to configure webhooks: %w", err)
+			}
+
+			store, err := driver.Open(cfg.Storage)
+			if err != nil {
+				return fmt.Errorf("failed to open storage: %w", err)
+			}
+			defer closeStorage(cmd, store)
+
+			letters, err := store.GetDeadLetters()
+			if err != nil {
+				return fmt.Errorf("failed to get dead letters: %w", err)
+			}
+
+			switch {
+			case purge:
+				for _, letter := range letters {
+					if err := store.DeleteDeadLetter(letter.ID); err != nil {
+						return fmt.Errorf("failed to delete dead letter: %w", err)
+					}
+				}
+
+				fmt.Printf("removed %d dead letters\n", len(letters))
+
+				return nil
+			case retry:
+				dispatcher := webhook.NewDispatcher(webhooks, store)
+
+				var delivered int
+
+				for _, letter := range letters {
+					i := slices.IndexFunc(webhooks, func(w *webhook.Webhook) bool { return w.Name == letter.Webhook })
+					if i < 0 {
+						fmt.Printf("%s: webhook %q is not configured anymore\n", letter.ID, letter.Webhook)
+						continue
+					}
+
+					// Letters queued before the delivery ID was stored fall back to their own ID.
+					deliveryID := letter.DeliveryID
+					if deliveryID == "" {
+						deliveryID = letter.ID
+					}
+
+					err := dispatcher.Send(
+						cmd.Context(), webhooks[i], letter.URL, deliveryID, event.EventType(letter.EventType), letter.Payload,
+					)
+					if err != nil {
+						fmt.Printf("%s: %s\n", letter.ID, err)
+						continue
+					}
+
+					if err := store.DeleteDeadLetter(letter.ID); err != nil {
+						return fmt.Errorf("failed to delete dead letter: %w", err)
+					}
+
+					delivered++
+				}
+
+				fmt.Printf("delivered %d of %d dead letters\n", delivered, len(letters))
+
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:mnd
+
+			fmt.Fprintln(tw, "ID\tWEBHOOK\tEVENT\tURL\tATTEMPTS\tFAILED AT\tERROR")
+
+			for _, letter := range letters {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+					letter.ID, letter.Webhook, letter.EventType, letter.URL, letter.Attempts,
+					letter.CreatedAt.Local().Format(time.DateTime), strings.ReplaceAll(letter.LastError, "\n", " "))
+			}
+
+			return tw.Flush()
+		},
+	}
+
+	deadLettersCmd.Flags().Bool("retry", false, "send the queued deliveries again")
+	deadLettersCmd.Flags().Bool("purge", false, "remove all queued deliveries")
+
+	return deadLettersCmd
+}
+
+// configuredWebhooks returns the webhooks of the config file with the given names, or all of them.
+func configuredWebhooks(names []string) ([]*webhook.Webhook, error) {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	webhooks, err := webhook.New(cfg.Webhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure webhooks: %w", err)
+	}
+
+	if len(webhooks) == 0 {
+		return nil, fmt.Errorf("no webhooks are configured")
+	}
+
+	if len(names) == 0 {
+		return webhooks, nil
+	}
+
+	selected := make([]*webhook.Webhook, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(webhooks, func(w *webhook.Webhook) bool { return w.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("webhook %q is not configured", name)
+		}
+
+		selected = append(selected, webhooks[i])
+	}
+
+	return selected, nil
+}
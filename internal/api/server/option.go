@@ -7,19 +7,51 @@ import (
 
 	"github.com/hatappi/go-kit/log"
 
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/hook"
+	"github.com/hatappi/gomodoro/internal/notification"
 	"github.com/hatappi/gomodoro/internal/pixela"
 	"github.com/hatappi/gomodoro/internal/toggl"
+	"github.com/hatappi/gomodoro/internal/webhook"
 )
 
 // Option represents a function that configures the server.
 type Option func(*Server)
 
+// WithEventLog enables replaying recorded events to subscriptions that pass sinceSequence.
+func WithEventLog(eventLog *core.EventLog) Option {
+	return func(a *Server) {
+		a.eventLog = eventLog
+	}
+}
+
+// WithWebhooks delivers the events of the bus to the webhooks of the dispatcher.
+func WithWebhooks(dispatcher *webhook.Dispatcher) Option {
+	return func(a *Server) {
+		a.webhooks = dispatcher
+	}
+}
+
+// WithHooks runs the commands of the executor on the events of the bus.
+func WithHooks(executor *hook.Executor) Option {
+	return func(a *Server) {
+		a.hooks = executor
+	}
+}
+
+// WithNotifications notifies the channels of the dispatcher when a phase ends.
+func WithNotifications(dispatcher *notification.Dispatcher) Option {
+	return func(a *Server) {
+		a.notifications = dispatcher
+	}
+}
+
 // WithRecordToggl adds Toggl time tracking functionality.
 func WithRecordToggl(togglClient *toggl.Client) Option {
 	return func(a *Server) {
 		a.completeFuncs = append(
 			a.completeFuncs,
-			func(ctx context.Context, taskName string, isWorkTime bool, elapsedTime time.Duration) error {
+			func(ctx context.Context, task *core.Task, isWorkTime bool, elapsedTime time.Duration) error {
 				if !isWorkTime {
 					return nil
 				}
@@ -28,7 +60,7 @@ func WithRecordToggl(togglClient *toggl.Client) Option {
 
 				s := time.Now().Add(-time.Duration(elapsedTimeSec))
 
-				if err := togglClient.PostTimeEntry(ctx, taskName, s, elapsedTimeSec); err != nil {
+				if err := togglClient.PostTimeEntry(ctx, task.Title, task.Tags, s, elapsedTimeSec); err != nil {
 					return fmt.Errorf("failed to post time entry to Toggl: %w", err)
 				}
 
@@ -43,7 +75,7 @@ func WithRecordPixela(client *pixela.Client, userName, graphID string) Option {
 	return func(a *Server) {
 		a.completeFuncs = append(
 			a.completeFuncs,
-			func(ctx context.Context, _ string, isWorkTime bool, _ time.Duration) error {
+			func(ctx context.Context, _ *core.Task, isWorkTime bool, _ time.Duration) error {
 				if !isWorkTime {
 					return nil
 				}
@@ -63,10 +95,10 @@ func WithCompletionLogging() Option {
 	return func(a *Server) {
 		a.completeFuncs = append(
 			a.completeFuncs,
-			func(ctx context.Context, taskName string, isWorkTime bool, elapsedTime time.Duration) error {
+			func(ctx context.Context, task *core.Task, isWorkTime bool, elapsedTime time.Duration) error {
 				log.FromContext(ctx).Info(
 					"Pomodoro completed",
-					"taskName", taskName,
+					"taskName", task.Title,
 					"isWorkTime", isWorkTime,
 					"elapsedTimeSec", elapsedTime.Seconds(),
 				)
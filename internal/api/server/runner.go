@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -12,9 +13,13 @@ import (
 	"github.com/hatappi/gomodoro/internal/config"
 	"github.com/hatappi/gomodoro/internal/core"
 	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/hook"
+	"github.com/hatappi/gomodoro/internal/notification"
 	"github.com/hatappi/gomodoro/internal/pixela"
-	"github.com/hatappi/gomodoro/internal/storage/file"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/driver"
 	"github.com/hatappi/gomodoro/internal/toggl"
+	"github.com/hatappi/gomodoro/internal/webhook"
 )
 
 const (
@@ -24,31 +29,42 @@ const (
 
 // Runner manages API server lifecycle.
 type Runner struct {
-	config          *config.Config
-	eventBus        event.EventBus
-	taskService     *core.TaskService
-	pomodoroService *core.PomodoroService
+	config        *config.Config
+	webhooks      []*webhook.Webhook
+	hooks         *hook.Executor
+	notifications *notification.Dispatcher
 
+	// storage is opened when the server starts, so that a command served by another process does not open it.
+	storage   storage.Storage
 	server    *Server
 	isRunning bool
 	mu        sync.Mutex
 }
 
 // NewRunner creates a new server runner.
-func NewRunner(config *config.Config) *Runner {
-	fileStorage := file.NewFileStorage(config.Storage)
+// The configured storage is opened by Start and closed by Stop.
+func NewRunner(config *config.Config) (*Runner, error) {
+	webhooks, err := webhook.New(config.Webhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure webhooks: %w", err)
+	}
 
-	eventBus := event.NewInMemoryBus()
+	hooks, err := hook.New(config.Hooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure hooks: %w", err)
+	}
 
-	taskService := core.NewTaskService(fileStorage, eventBus)
-	pomodoroService := core.NewPomodoroService(fileStorage, eventBus)
+	notifications, err := notification.New(config.Notifications)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure notifications: %w", err)
+	}
 
 	return &Runner{
-		config:          config,
-		eventBus:        eventBus,
-		taskService:     taskService,
-		pomodoroService: pomodoroService,
-	}
+		config:        config,
+		webhooks:      webhooks,
+		hooks:         hooks,
+		notifications: notifications,
+	}, nil
 }
 
 // Start initializes and starts the API server.
@@ -60,8 +76,39 @@ func (r *Runner) Start(ctx context.Context) error {
 		return nil
 	}
 
+	store, err := driver.Open(r.config.Storage)
+	if err != nil {
+		return err
+	}
+
+	if err := r.start(ctx, store); err != nil {
+		closeStorage(ctx, store)
+		r.server = nil
+
+		return err
+	}
+
+	r.storage = store
+	r.isRunning = true
+
+	return nil
+}
+
+// start serves the API backed by store.
+func (r *Runner) start(ctx context.Context, store storage.Storage) error {
+	eventBus := event.NewInMemoryBus(
+		event.WithBufferSize(r.config.API.EventBufferSize),
+		event.WithOverflowPolicy(event.OverflowPolicy(r.config.API.EventOverflowPolicy)),
+	)
+	eventLog := core.NewEventLog(store, eventBus)
+
+	taskService := core.NewTaskService(store, eventLog)
+	pomodoroService := core.NewPomodoroService(store, eventLog)
+	statsService := core.NewStatsService(store)
+
 	opts := []Option{
 		WithCompletionLogging(),
+		WithEventLog(eventLog),
 	}
 
 	if r.config.Toggl.Enable {
@@ -74,22 +121,46 @@ func (r *Runner) Start(ctx context.Context) error {
 		opts = append(opts, WithRecordPixela(pixelaClient, r.config.Pixela.UserName, r.config.Pixela.GraphID))
 	}
 
-	r.server = NewServer(r.config.API, r.pomodoroService, r.taskService, r.eventBus, opts...)
+	if len(r.webhooks) > 0 {
+		opts = append(opts, WithWebhooks(webhook.NewDispatcher(r.webhooks, store)))
+	}
+
+	if len(r.hooks.EventTypes()) > 0 {
+		opts = append(opts, WithHooks(r.hooks))
+	}
+
+	if r.notifications.Enabled() {
+		opts = append(opts, WithNotifications(r.notifications))
+	}
+
+	r.server = NewServer(r.config.API, pomodoroService, taskService, statsService, eventLog, opts...)
 
 	ln, err := r.server.Listen()
 	if err != nil {
 		return err
 	}
 
-	latest, err := r.pomodoroService.LatestPomodoro()
-	if err != nil {
-		return fmt.Errorf("failed to get latest pomodoro: %w", err)
-	}
+	// The handlers have to be subscribed before recovery so that a session
+	// which ran out while the server was down still reaches them.
+	r.server.StartEventHandlers(ctx)
+
+	recovered, err := pomodoroService.Recover(ctx)
+
+	switch {
+	case err != nil:
+		// The session is kept, so that it can still be recovered when the server starts next time.
+		log.FromContext(ctx).Error(err, "Failed to recover pomodoro")
+	case recovered == nil:
+		// Without a session to continue, a finished session is cleared so that a new cycle starts.
+		latest, err := pomodoroService.LatestPomodoro()
+		if err != nil {
+			return fmt.Errorf("failed to get latest pomodoro: %w", err)
+		}
 
-	// If there's an active pomodoro, delete it to clean up the state
-	if latest != nil {
-		if err := r.pomodoroService.Delete(ctx, latest.ID); err != nil {
-			log.FromContext(ctx).Error(err, "Failed to delete latest pomodoro")
+		if latest != nil {
+			if err := pomodoroService.Delete(ctx, latest.ID); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to delete latest pomodoro")
+			}
 		}
 	}
 
@@ -99,8 +170,6 @@ func (r *Runner) Start(ctx context.Context) error {
 		}
 	}()
 
-	r.isRunning = true
-
 	return nil
 }
 
@@ -118,21 +187,40 @@ func (r *Runner) Stop(ctx context.Context) error {
 
 	err := r.server.Stop(stopCtx)
 
+	closeStorage(ctx, r.storage)
+
 	r.mu.Lock()
 	r.isRunning = false
 	r.server = nil
+	r.storage = nil
 	r.mu.Unlock()
 
 	return err
 }
 
+// closeStorage closes a storage that holds resources, e.g. a SQLite database.
+func closeStorage(ctx context.Context, store storage.Storage) {
+	if closer, ok := store.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to close storage")
+		}
+	}
+}
+
+// IsRunning reports whether this runner serves the API, as opposed to another process.
+func (r *Runner) IsRunning() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.isRunning
+}
+
 // EnsureRunning checks if the API server is running and starts it if not.
 // It uses the client to perform health checks.
 func (r *Runner) EnsureRunning(ctx context.Context) error {
 	gqlClient := graphql.NewClientWrapper(r.config.API)
 
-	_, err := gqlClient.GetCurrentPomodoro(ctx)
-	if err == nil {
+	if err := gqlClient.Ping(ctx); err == nil {
 		return nil
 	}
 
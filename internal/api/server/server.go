@@ -23,6 +23,9 @@ import (
 	"github.com/hatappi/gomodoro/internal/core/event"
 	"github.com/hatappi/gomodoro/internal/graph"
 	"github.com/hatappi/gomodoro/internal/graph/resolver"
+	"github.com/hatappi/gomodoro/internal/hook"
+	"github.com/hatappi/gomodoro/internal/notification"
+	"github.com/hatappi/gomodoro/internal/webhook"
 )
 
 // Server represents the API server.
@@ -32,9 +35,14 @@ type Server struct {
 	httpServer      *http.Server
 	pomodoroService *core.PomodoroService
 	taskService     *core.TaskService
+	statsService    *core.StatsService
 	eventBus        event.EventBus
+	eventLog        *core.EventLog
+	webhooks        *webhook.Dispatcher
+	hooks           *hook.Executor
+	notifications   *notification.Dispatcher
 
-	completeFuncs []func(ctx context.Context, taskName string, isWorkTime bool, elapsedTime time.Duration) error
+	completeFuncs []func(ctx context.Context, task *core.Task, isWorkTime bool, elapsedTime time.Duration) error
 }
 
 // NewServer creates a new API server instance.
@@ -42,6 +50,7 @@ func NewServer(
 	config config.APIConfig,
 	pomodoroService *core.PomodoroService,
 	taskService *core.TaskService,
+	statsService *core.StatsService,
 	eventBus event.EventBus,
 	opts ...Option,
 ) *Server {
@@ -52,6 +61,7 @@ func NewServer(
 		router:          router,
 		pomodoroService: pomodoroService,
 		taskService:     taskService,
+		statsService:    statsService,
 		eventBus:        eventBus,
 	}
 
@@ -74,8 +84,10 @@ func (s *Server) setupMiddleware() {
 func (s *Server) setupGraphQL(eventBus event.EventBus) {
 	resolver := &resolver.Resolver{
 		EventBus:        eventBus,
+		EventLog:        s.eventLog,
 		TaskService:     s.taskService,
 		PomodoroService: s.pomodoroService,
+		StatsService:    s.statsService,
 	}
 
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
@@ -112,10 +124,32 @@ func (s *Server) Listen() (net.Listener, error) {
 	return ln, nil
 }
 
+// StartEventHandlers subscribes the completion handlers, the webhooks, the hooks and the notifications
+// to the event bus.
+// Events published before this call are not seen by the handlers.
+func (s *Server) StartEventHandlers(ctx context.Context) {
+	busCh, unsubscribe := s.eventBus.SubscribeChannel(
+		[]event.EventType{event.PomodoroStopped, event.PomodoroCompleted},
+		event.WithSubscriberName("completion handlers"),
+	)
+
+	go s.handlePomodoroCompletionEvents(ctx, busCh, unsubscribe)
+
+	if s.webhooks != nil {
+		s.webhooks.Start(ctx, s.eventBus)
+	}
+
+	if s.hooks != nil {
+		s.hooks.Start(ctx, s.eventBus)
+	}
+
+	if s.notifications != nil {
+		s.notifications.Start(ctx, s.eventBus, s.taskService)
+	}
+}
+
 // Start the HTTP server and blocks until it is stopped.
 func (s *Server) Start(ctx context.Context, ln net.Listener) error {
-	go s.handlePomodoroCompletionEvents(ctx)
-
 	log.FromContext(ctx).V(1).Info("Serving API server", "addr", s.config.Addr)
 	if err := s.httpServer.Serve(ln); err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("failed to serve: %w", err)
@@ -134,11 +168,28 @@ func (s *Server) Stop(ctx context.Context) error {
 		}
 	}
 
+	if s.webhooks != nil {
+		if err := s.webhooks.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop webhooks: %w", err)
+		}
+	}
+
+	if s.hooks != nil {
+		if err := s.hooks.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop hooks: %w", err)
+		}
+	}
+
+	if s.notifications != nil {
+		if err := s.notifications.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop notifications: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func (s *Server) handlePomodoroCompletionEvents(ctx context.Context) {
-	busCh, unsubscribe := s.eventBus.SubscribeChannel([]event.EventType{event.PomodoroStopped, event.PomodoroCompleted})
+func (s *Server) handlePomodoroCompletionEvents(ctx context.Context, busCh <-chan interface{}, unsubscribe func()) {
 	defer unsubscribe()
 
 	for {
@@ -164,7 +215,7 @@ func (s *Server) handlePomodoroCompletionEvents(ctx context.Context) {
 			isWorkTime := pomodoroEvent.Phase == event.PomodoroPhaseWork
 
 			for _, completeFunc := range s.completeFuncs {
-				if err := completeFunc(ctx, task.Title, isWorkTime, pomodoroEvent.ElapsedTime); err != nil {
+				if err := completeFunc(ctx, task, isWorkTime, pomodoroEvent.ElapsedTime); err != nil {
 					log.FromContext(ctx).Error(err, "Failed to execute complete function", "taskID", pomodoroEvent.TaskID)
 				}
 			}
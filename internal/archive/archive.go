@@ -0,0 +1,199 @@
+// Package archive exports all data of a storage to a versioned JSON archive and imports it back,
+// so that the data can be backed up or moved to another machine or storage driver.
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+// Version is the version of the archive format written by Write.
+// It is incremented whenever a change needs archives of older versions to be converted on import.
+const Version = 1
+
+// Archive is a snapshot of all data of a storage.
+type Archive struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	// Config is the configuration at the time of the export, for reference only.
+	Config *ConfigSnapshot `json:"config,omitempty"`
+	Tasks  []*storage.Task `json:"tasks"`
+	// History holds the recorded sessions in chronological order.
+	History []*storage.Pomodoro `json:"history"`
+	// Current is the latest session, if there is one.
+	Current *storage.Pomodoro `json:"current,omitempty"`
+}
+
+// ConfigSnapshot is the part of the configuration kept in an archive. Credentials are left out.
+type ConfigSnapshot struct {
+	WorkSec        int    `json:"work_sec"`
+	ShortBreakSec  int    `json:"short_break_sec"`
+	LongBreakSec   int    `json:"long_break_sec"`
+	BreakFrequency int    `json:"break_frequency"`
+	StorageDriver  string `json:"storage_driver"`
+	TogglEnabled   bool   `json:"toggl_enabled"`
+	PixelaEnabled  bool   `json:"pixela_enabled"`
+}
+
+// NewConfigSnapshot returns the snapshot of cfg to keep in an archive.
+func NewConfigSnapshot(cfg *config.Config) *ConfigSnapshot {
+	return &ConfigSnapshot{
+		WorkSec:        cfg.Pomodoro.WorkSec,
+		ShortBreakSec:  cfg.Pomodoro.ShortBreakSec,
+		LongBreakSec:   cfg.Pomodoro.LongBreakSec,
+		BreakFrequency: cfg.Pomodoro.BreakFrequency,
+		StorageDriver:  cfg.Storage.Driver,
+		TogglEnabled:   cfg.Toggl.Enable,
+		PixelaEnabled:  cfg.Pixela.Enable,
+	}
+}
+
+// Export reads all data of s into an archive.
+func Export(s storage.Storage, now time.Time) (*Archive, error) {
+	tasks, err := s.GetTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	history, err := s.GetPomodoroHistory(time.Time{}, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	current, err := s.GetLatestPomodoro()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest pomodoro: %w", err)
+	}
+
+	return &Archive{
+		Version:    Version,
+		ExportedAt: now,
+		Tasks:      tasks,
+		History:    history,
+		Current:    current,
+	}, nil
+}
+
+// Write writes an archive as indented JSON.
+func Write(w io.Writer, a *Archive) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(a); err != nil {
+		return fmt.Errorf("failed to encode archive: %w", err)
+	}
+
+	return nil
+}
+
+// Read reads an archive written by Write, rejecting versions this build does not know.
+func Read(r io.Reader) (*Archive, error) {
+	var a Archive
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return nil, fmt.Errorf("failed to decode archive: %w", err)
+	}
+
+	switch {
+	case a.Version <= 0:
+		return nil, fmt.Errorf("not a gomodoro archive: missing version")
+	case a.Version > Version:
+		return nil, fmt.Errorf("archive version %d is newer than supported version %d", a.Version, Version)
+	}
+
+	return &a, nil
+}
+
+// ImportResult counts what Import added and what it skipped because it was already there.
+type ImportResult struct {
+	Tasks           int
+	SkippedTasks    int
+	Sessions        int
+	SkippedSessions int
+	// Current reports whether the latest session was restored.
+	Current bool
+}
+
+// Import adds the data of an archive to s. Tasks and sessions whose IDs already exist are skipped,
+// so importing the same archive twice does not duplicate anything.
+// The latest session is only restored if s has none.
+func Import(s storage.Storage, a *Archive) (*ImportResult, error) {
+	result := &ImportResult{}
+
+	tasks, err := s.GetTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	taskIDs := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		taskIDs[task.ID] = true
+	}
+
+	for _, task := range a.Tasks {
+		if taskIDs[task.ID] {
+			result.SkippedTasks++
+			continue
+		}
+
+		if err := s.SaveTask(task); err != nil {
+			return result, fmt.Errorf("failed to save task %s: %w", task.ID, err)
+		}
+
+		taskIDs[task.ID] = true
+		result.Tasks++
+	}
+
+	history, err := s.GetPomodoroHistory(time.Time{}, time.Time{})
+	if err != nil {
+		return result, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	sessionIDs := make(map[string]bool, len(history))
+	for _, p := range history {
+		sessionIDs[p.ID] = true
+	}
+
+	sessions := slices.Clone(a.History)
+	slices.SortStableFunc(sessions, func(a, b *storage.Pomodoro) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	for _, p := range sessions {
+		if sessionIDs[p.ID] {
+			result.SkippedSessions++
+			continue
+		}
+
+		if err := s.AddPomodoroHistory(p); err != nil {
+			return result, fmt.Errorf("failed to add pomodoro %s to the history: %w", p.ID, err)
+		}
+
+		sessionIDs[p.ID] = true
+		result.Sessions++
+	}
+
+	if a.Current == nil {
+		return result, nil
+	}
+
+	latest, err := s.GetLatestPomodoro()
+	if err != nil {
+		return result, fmt.Errorf("failed to get latest pomodoro: %w", err)
+	}
+
+	if latest == nil {
+		if err := s.SavePomodoro(a.Current); err != nil {
+			return result, fmt.Errorf("failed to save pomodoro: %w", err)
+		}
+
+		result.Current = true
+	}
+
+	return result, nil
+}
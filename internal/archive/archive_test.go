@@ -0,0 +1,165 @@
+package archive_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/archive"
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/file"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+	"github.com/hatappi/gomodoro/internal/storage/sqlite"
+)
+
+var epoch = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func newSource(t *testing.T) storage.Storage {
+	t.Helper()
+
+	s := memory.NewMemoryStorage()
+
+	for i, title := range []string{"write docs", "review"} {
+		err := s.SaveTask(&storage.Task{
+			ID:        title,
+			Title:     title,
+			Tags:      []string{"work"},
+			Status:    storage.TaskStatusTodo,
+			CreatedAt: epoch.Add(time.Duration(i) * time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("SaveTask() error = %v", err)
+		}
+	}
+
+	for i, id := range []string{"p1", "p2"} {
+		start := epoch.Add(time.Duration(i) * time.Hour)
+
+		err := s.AddPomodoroHistory(&storage.Pomodoro{
+			ID:            id,
+			State:         storage.PomodoroStateFinished,
+			StartTime:     start,
+			EndTime:       start.Add(25 * time.Minute),
+			ElapsedTime:   25 * time.Minute,
+			Phase:         storage.PomodoroPhaseWork,
+			PhaseDuration: 25 * time.Minute,
+			PhaseCount:    1,
+			TaskID:        "write docs",
+		})
+		if err != nil {
+			t.Fatalf("AddPomodoroHistory() error = %v", err)
+		}
+	}
+
+	err := s.SavePomodoro(&storage.Pomodoro{
+		ID:            "current",
+		State:         storage.PomodoroStatePaused,
+		StartTime:     epoch.Add(2 * time.Hour),
+		RemainingTime: 10 * time.Minute,
+		Phase:         storage.PomodoroPhaseWork,
+		PhaseDuration: 25 * time.Minute,
+		PhaseCount:    3,
+	})
+	if err != nil {
+		t.Fatalf("SavePomodoro() error = %v", err)
+	}
+
+	return s
+}
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	targets := map[string]func(t *testing.T) storage.Storage{
+		"memory": func(_ *testing.T) storage.Storage {
+			return memory.NewMemoryStorage()
+		},
+		"file": func(t *testing.T) storage.Storage {
+			return file.NewFileStorage(config.StorageConfig{Dir: t.TempDir()})
+		},
+		"sqlite": func(t *testing.T) storage.Storage {
+			s, err := sqlite.NewSQLiteStorage(config.StorageConfig{Dir: t.TempDir()})
+			if err != nil {
+				t.Fatalf("NewSQLiteStorage() error = %v", err)
+			}
+
+			t.Cleanup(func() { _ = s.Close() })
+
+			return s
+		},
+	}
+
+	for name, newTarget := range targets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exported, err := archive.Export(newSource(t), epoch)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := archive.Write(&buf, exported); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+
+			a, err := archive.Read(&buf)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+
+			target := newTarget(t)
+
+			result, err := archive.Import(target, a)
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			want := archive.ImportResult{Tasks: 2, Sessions: 2, Current: true}
+			if *result != want {
+				t.Errorf("Import() = %+v, want %+v", *result, want)
+			}
+
+			reimported, err := archive.Export(target, epoch)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			tasks := reimported.Tasks
+			if len(tasks) != 2 || tasks[0].Title != "write docs" || tasks[0].Tags[0] != "work" {
+				t.Errorf("tasks = %+v", reimported.Tasks)
+			}
+
+			history := reimported.History
+			if len(history) != 2 || history[1].ID != "p2" || history[1].TaskID != "write docs" {
+				t.Errorf("history = %+v", reimported.History)
+			}
+
+			if reimported.Current == nil || reimported.Current.ID != "current" {
+				t.Errorf("current = %+v", reimported.Current)
+			}
+
+			result, err = archive.Import(target, a)
+			if err != nil {
+				t.Fatalf("second Import() error = %v", err)
+			}
+
+			want = archive.ImportResult{SkippedTasks: 2, SkippedSessions: 2}
+			if *result != want {
+				t.Errorf("second Import() = %+v, want %+v", *result, want)
+			}
+		})
+	}
+}
+
+func TestReadRejectsUnknownVersions(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{`{"tasks": []}`, `{"version": 99}`} {
+		if _, err := archive.Read(strings.NewReader(input)); err == nil {
+			t.Errorf("Read(%s) succeeded, want error", input)
+		}
+	}
+}
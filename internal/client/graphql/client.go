@@ -13,6 +13,7 @@ import (
 
 	gqllib "github.com/Khan/genqlient/graphql"
 	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/hatappi/gomodoro/internal/client/graphql/conv"
 	gqlgen "github.com/hatappi/gomodoro/internal/client/graphql/generated"
@@ -27,6 +28,9 @@ const (
 
 	// defaultHandshakeTimeout is the default timeout for WebSocket handshaking.
 	defaultHandshakeTimeout = 45 * time.Second // Added constant
+
+	// historyPageSize is the number of sessions fetched per request when reading the history.
+	historyPageSize = 100
 )
 
 // ClientWrapper wraps the genqlient clients for query/mutation and subscriptions.
@@ -179,6 +183,48 @@ func (c *ClientWrapper) GetAllTasks(ctx context.Context) ([]*core.Task, error) {
 	return result, nil
 }
 
+// TaskFilter narrows down the tasks returned by ListTasks. Zero fields match every task.
+type TaskFilter struct {
+	// TitleContains is a case-insensitive substring of the title.
+	TitleContains string
+	Tag           string
+	Statuses      []event.TaskStatus
+}
+
+// ListTasks retrieves the tasks matching filter from the server, oldest first.
+func (c *ClientWrapper) ListTasks(ctx context.Context, filter TaskFilter) ([]*core.Task, error) {
+	var input gqlgen.TaskFilter
+
+	if filter.TitleContains != "" {
+		input.TitleContains = &filter.TitleContains
+	}
+
+	if filter.Tag != "" {
+		input.Tag = &filter.Tag
+	}
+
+	for _, status := range filter.Statuses {
+		s, err := conv.FromTaskStatus(status)
+		if err != nil {
+			return nil, err
+		}
+
+		input.Status = append(input.Status, s)
+	}
+
+	res, err := gqlgen.ListTasks(ctx, c.queryClient, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	result := make([]*core.Task, 0, len(res.Tasks.Edges))
+	for _, edge := range res.Tasks.Edges {
+		result = append(result, conv.ToCoreTask(edge.Node.TaskDetails))
+	}
+
+	return result, nil
+}
+
 // GetTask retrieves a task by ID from the server.
 func (c *ClientWrapper) GetTask(ctx context.Context, id string) (*core.Task, error) {
 	res, err := gqlgen.GetTask(ctx, c.queryClient, id)
@@ -190,8 +236,8 @@ func (c *ClientWrapper) GetTask(ctx context.Context, id string) (*core.Task, err
 }
 
 // CreateTask creates a new task on the server.
-func (c *ClientWrapper) CreateTask(ctx context.Context, title string) (*core.Task, error) {
-	res, err := gqlgen.CreateTask(ctx, c.queryClient, title)
+func (c *ClientWrapper) CreateTask(ctx context.Context, title string, details core.TaskDetails) (*core.Task, error) {
+	res, err := gqlgen.CreateTask(ctx, c.queryClient, conv.ToCreateTaskInput(title, details))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
@@ -199,6 +245,51 @@ func (c *ClientWrapper) CreateTask(ctx context.Context, title string) (*core.Tas
 	return conv.ToCoreTask(res.CreateTask.TaskDetails), nil
 }
 
+// UpdateTask updates the given fields of a task on the server.
+func (c *ClientWrapper) UpdateTask(ctx context.Context, id string, update core.TaskUpdate) (*core.Task, error) {
+	res, err := gqlgen.UpdateTask(ctx, c.queryClient, conv.ToUpdateTaskInput(id, update))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	return conv.ToCoreTask(res.UpdateTask.TaskDetails), nil
+}
+
+// UpdateTaskStatus moves a task to the given status on the server.
+func (c *ClientWrapper) UpdateTaskStatus(ctx context.Context, id string, status event.TaskStatus) (*core.Task, error) {
+	taskStatus, err := conv.FromTaskStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gqlgen.UpdateTaskStatus(ctx, c.queryClient, id, taskStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task status: %w", err)
+	}
+
+	return conv.ToCoreTask(res.UpdateTaskStatus.TaskDetails), nil
+}
+
+// CompleteTask marks a task as done on the server.
+func (c *ClientWrapper) CompleteTask(ctx context.Context, id string) (*core.Task, error) {
+	res, err := gqlgen.CompleteTask(ctx, c.queryClient, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete task: %w", err)
+	}
+
+	return conv.ToCoreTask(res.CompleteTask.TaskDetails), nil
+}
+
+// ArchiveTask archives a task on the server.
+func (c *ClientWrapper) ArchiveTask(ctx context.Context, id string) (*core.Task, error) {
+	res, err := gqlgen.ArchiveTask(ctx, c.queryClient, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive task: %w", err)
+	}
+
+	return conv.ToCoreTask(res.ArchiveTask.TaskDetails), nil
+}
+
 // DeleteTask deletes a task on the server.
 func (c *ClientWrapper) DeleteTask(ctx context.Context, id string) error {
 	res, err := gqlgen.DeleteTask(ctx, c.queryClient, id)
@@ -213,6 +304,19 @@ func (c *ClientWrapper) DeleteTask(ctx context.Context, id string) error {
 	return fmt.Errorf("failed to delete task")
 }
 
+// Ping checks that the server can be reached.
+// A query that the server answers with GraphQL errors, e.g. because there is no current pomodoro, still counts.
+func (c *ClientWrapper) Ping(ctx context.Context) error {
+	_, err := gqlgen.GetCurrentPomodoro(ctx, c.queryClient)
+
+	var gqlErrs gqlerror.List
+	if err == nil || errors.As(err, &gqlErrs) {
+		return nil
+	}
+
+	return fmt.Errorf("failed to reach server: %w", err)
+}
+
 // GetCurrentPomodoro retrieves the current active pomodoro session from the server.
 func (c *ClientWrapper) GetCurrentPomodoro(ctx context.Context) (*core.Pomodoro, error) {
 	res, err := gqlgen.GetCurrentPomodoro(ctx, c.queryClient)
@@ -223,6 +327,27 @@ func (c *ClientWrapper) GetCurrentPomodoro(ctx context.Context) (*core.Pomodoro,
 	return conv.ToCorePomodoro(res.GetCurrentPomodoro().PomodoroDetails)
 }
 
+// GetCurrentStatus retrieves the current pomodoro and its task in one request.
+// The task is nil if the pomodoro has none.
+func (c *ClientWrapper) GetCurrentStatus(ctx context.Context) (*core.Pomodoro, *core.Task, error) {
+	res, err := gqlgen.GetCurrentStatus(ctx, c.queryClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get current status: %w", err)
+	}
+
+	pomodoro, err := conv.ToCorePomodoro(res.CurrentPomodoro.PomodoroDetails)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var task *core.Task
+	if res.CurrentPomodoro.Task != nil {
+		task = conv.ToCoreTask(res.CurrentPomodoro.Task.TaskDetails)
+	}
+
+	return pomodoro, task, nil
+}
+
 // StartPomodoro starts a new pomodoro session on the server.
 func (c *ClientWrapper) StartPomodoro(ctx context.Context, input gqlgen.StartPomodoroInput) (*core.Pomodoro, error) {
 	res, err := gqlgen.StartPomodoro(ctx, c.queryClient, input)
@@ -272,3 +397,55 @@ func (c *ClientWrapper) ResetPomodoro(ctx context.Context) (*core.Pomodoro, erro
 
 	return conv.ToCorePomodoro(res.ResetPomodoro.PomodoroDetails)
 }
+
+// GetPomodoroHistory retrieves the sessions started within [start, end) in chronological order.
+// It follows the pages of the history until the last one.
+func (c *ClientWrapper) GetPomodoroHistory(ctx context.Context, start, end time.Time) ([]*core.Pomodoro, error) {
+	filter := gqlgen.PomodoroFilter{StartedAfter: &start, StartedBefore: &end}
+
+	var (
+		pomodoros []*core.Pomodoro
+		after     *string
+	)
+
+	for {
+		res, err := gqlgen.GetPomodoroHistory(ctx, c.queryClient, filter, historyPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+		}
+
+		for _, edge := range res.Pomodoros.Edges {
+			pomodoro, err := conv.ToCorePomodoro(edge.Node.PomodoroDetails)
+			if err != nil {
+				return nil, err
+			}
+
+			pomodoros = append(pomodoros, pomodoro)
+		}
+
+		if !res.Pomodoros.PageInfo.HasNextPage {
+			return pomodoros, nil
+		}
+
+		after = &res.Pomodoros.PageInfo.EndCursor
+	}
+}
+
+// GetStats retrieves the aggregates of the work sessions started within [start, end).
+func (c *ClientWrapper) GetStats(
+	ctx context.Context,
+	start, end time.Time,
+	groupBy core.StatsGroupBy,
+) (*core.Stats, error) {
+	gqlGroupBy, err := conv.FromStatsGroupBy(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gqlgen.GetStats(ctx, c.queryClient, gqlgen.StatsRange{Start: start, End: end}, gqlGroupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats: %w", err)
+	}
+
+	return conv.ToCoreStats(res.Stats.StatsDetails), nil
+}
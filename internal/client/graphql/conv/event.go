@@ -20,6 +20,7 @@ func ToEventInfo(evt gqlgen.EventDetails) (event.EventInfo, error) {
 	baseEvent := event.BaseEvent{
 		Type:      eventType,
 		Timestamp: time.Now(),
+		Sequence:  int64(evt.Sequence),
 	}
 
 	switch payload := evt.Payload.(type) {
@@ -34,9 +35,14 @@ func ToEventInfo(evt gqlgen.EventDetails) (event.EventInfo, error) {
 
 func toTaskEvent(baseEvent event.BaseEvent, payload gqlgen.EventTaskPayloadDetails) event.TaskEvent {
 	return event.TaskEvent{
-		BaseEvent: baseEvent,
-		ID:        payload.Id,
-		Title:     payload.Title,
+		BaseEvent:          baseEvent,
+		ID:                 payload.Id,
+		Title:              payload.Title,
+		Project:            payload.Project,
+		Tags:               payload.Tags,
+		EstimatedPomodoros: payload.EstimatedPomodoros,
+		Notes:              payload.Notes,
+		Status:             toTaskStatus(payload.Status),
 	}
 }
 
@@ -55,14 +61,16 @@ func toPomodoroEvent(
 	}
 
 	return event.PomodoroEvent{
-		BaseEvent:     baseEvent,
-		ID:            payload.Id,
-		State:         state,
-		RemainingTime: time.Duration(payload.RemainingTimeSec) * time.Second,
-		ElapsedTime:   time.Duration(payload.ElapsedTimeSec) * time.Second,
-		TaskID:        payload.TaskId,
-		Phase:         phase,
-		PhaseCount:    payload.PhaseCount,
+		BaseEvent:      baseEvent,
+		ID:             payload.Id,
+		State:          state,
+		RemainingTime:  time.Duration(payload.RemainingTimeSec) * time.Second,
+		ElapsedTime:    time.Duration(payload.ElapsedTimeSec) * time.Second,
+		TaskID:         payload.TaskId,
+		Phase:          phase,
+		PhaseCount:     payload.PhaseCount,
+		PhaseDuration:  time.Duration(payload.PhaseDurationSec) * time.Second,
+		BreakFrequency: payload.BreakFrequency,
 	}, nil
 }
 
@@ -86,6 +94,10 @@ func toEventType(eventType gqlgen.EventType) (event.EventType, error) {
 		return event.TaskUpdated, nil
 	case gqlgen.EventTypeTaskDeleted:
 		return event.TaskDeleted, nil
+	case gqlgen.EventTypeTaskCompleted:
+		return event.TaskCompleted, nil
+	case gqlgen.EventTypeTaskArchived:
+		return event.TaskArchived, nil
 	default:
 		return event.EventType(""), fmt.Errorf("unknown event type: %s", eventType)
 	}
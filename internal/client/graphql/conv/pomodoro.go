@@ -23,14 +23,18 @@ func ToCorePomodoro(pomodoro gqlgen.PomodoroDetails) (*core.Pomodoro, error) {
 	}
 
 	return &core.Pomodoro{
-		ID:            pomodoro.Id,
-		State:         state,
-		StartTime:     pomodoro.StartTime,
-		TaskID:        pomodoro.TaskId,
-		Phase:         phase,
-		PhaseCount:    pomodoro.PhaseCount,
-		RemainingTime: time.Duration(pomodoro.RemainingTimeSec) * time.Second,
-		ElapsedTime:   time.Duration(pomodoro.ElapsedTimeSec) * time.Second,
+		ID:             pomodoro.Id,
+		State:          state,
+		StartTime:      pomodoro.StartTime,
+		TaskID:         pomodoro.TaskId,
+		Phase:          phase,
+		PhaseCount:     pomodoro.PhaseCount,
+		RemainingTime:  time.Duration(pomodoro.RemainingTimeSec) * time.Second,
+		ElapsedTime:    time.Duration(pomodoro.ElapsedTimeSec) * time.Second,
+		PhaseDuration:  time.Duration(pomodoro.PhaseDurationSec) * time.Second,
+		BreakFrequency: pomodoro.BreakFrequency,
+		EndTime:        pomodoro.EndTime,
+		AutoAdvance:    pomodoro.AutoAdvance,
 	}, nil
 }
 
@@ -0,0 +1,57 @@
+package conv
+
+import (
+	"fmt"
+	"time"
+
+	gqlgen "github.com/hatappi/gomodoro/internal/client/graphql/generated"
+	"github.com/hatappi/gomodoro/internal/core"
+)
+
+// ToCoreStats converts a GraphQL StatsDetails to core Stats.
+func ToCoreStats(stats gqlgen.StatsDetails) *core.Stats {
+	groups := make([]*core.StatsGroup, len(stats.Groups))
+	for i, group := range stats.Groups {
+		groups[i] = &core.StatsGroup{
+			Key:   group.Key,
+			Start: group.Start,
+			End:   group.End,
+			FocusStats: core.FocusStats{
+				FocusTime:      time.Duration(group.FocusTimeSec) * time.Second,
+				Sessions:       group.Sessions,
+				Interruptions:  group.Interruptions,
+				BreakAdherence: group.BreakAdherence,
+			},
+		}
+	}
+
+	return &core.Stats{
+		Start: stats.Start,
+		End:   stats.End,
+		Total: core.FocusStats{
+			FocusTime:      time.Duration(stats.FocusTimeSec) * time.Second,
+			Sessions:       stats.Sessions,
+			Interruptions:  stats.Interruptions,
+			BreakAdherence: stats.BreakAdherence,
+		},
+		Groups: groups,
+	}
+}
+
+// FromStatsGroupBy converts a core StatsGroupBy to a GraphQL StatsGroupBy.
+func FromStatsGroupBy(groupBy core.StatsGroupBy) (gqlgen.StatsGroupBy, error) {
+	switch groupBy {
+	case core.StatsGroupByDay:
+		return gqlgen.StatsGroupByDay, nil
+	case core.StatsGroupByWeek:
+		return gqlgen.StatsGroupByWeek, nil
+	case core.StatsGroupByTask:
+		return gqlgen.StatsGroupByTask, nil
+	case core.StatsGroupByProject:
+		return gqlgen.StatsGroupByProject, nil
+	case core.StatsGroupByTag:
+		return gqlgen.StatsGroupByTag, nil
+	default:
+		return "", fmt.Errorf("unknown stats grouping: %s", groupBy)
+	}
+}
@@ -2,15 +2,86 @@
 package conv
 
 import (
+	"fmt"
+
 	gqlgen "github.com/hatappi/gomodoro/internal/client/graphql/generated"
 	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
 )
 
 // ToCoreTask converts a GraphQL Task to a core Task.
 func ToCoreTask(task gqlgen.TaskDetails) *core.Task {
 	return &core.Task{
-		ID:        task.Id,
-		Title:     task.Title,
-		CreatedAt: task.CreatedAt,
+		ID:                 task.Id,
+		Title:              task.Title,
+		Project:            task.Project,
+		Tags:               task.Tags,
+		EstimatedPomodoros: task.EstimatedPomodoros,
+		Notes:              task.Notes,
+		Status:             toTaskStatus(task.Status),
+		CreatedAt:          task.CreatedAt,
+	}
+}
+
+// FromTaskStatus converts an event TaskStatus to a GraphQL TaskStatus.
+func FromTaskStatus(status event.TaskStatus) (gqlgen.TaskStatus, error) {
+	switch status {
+	case event.TaskStatusTodo:
+		return gqlgen.TaskStatusTodo, nil
+	case event.TaskStatusInProgress:
+		return gqlgen.TaskStatusInProgress, nil
+	case event.TaskStatusDone:
+		return gqlgen.TaskStatusDone, nil
+	case event.TaskStatusArchived:
+		return gqlgen.TaskStatusArchived, nil
+	default:
+		return "", fmt.Errorf("unknown task status: %s", status)
+	}
+}
+
+func toTaskStatus(status gqlgen.TaskStatus) event.TaskStatus {
+	switch status {
+	case gqlgen.TaskStatusInProgress:
+		return event.TaskStatusInProgress
+	case gqlgen.TaskStatusDone:
+		return event.TaskStatusDone
+	case gqlgen.TaskStatusArchived:
+		return event.TaskStatusArchived
+	case gqlgen.TaskStatusTodo:
+		return event.TaskStatusTodo
+	default:
+		return event.TaskStatusTodo
+	}
+}
+
+// ToCreateTaskInput converts a title and core TaskDetails to a GraphQL CreateTaskInput.
+func ToCreateTaskInput(title string, details core.TaskDetails) gqlgen.CreateTaskInput {
+	return gqlgen.CreateTaskInput{
+		Title:              title,
+		Project:            details.Project,
+		Tags:               details.Tags,
+		EstimatedPomodoros: details.EstimatedPomodoros,
+		Notes:              details.Notes,
+	}
+}
+
+// ToUpdateTaskInput converts a core TaskUpdate to a GraphQL UpdateTaskInput.
+func ToUpdateTaskInput(id string, update core.TaskUpdate) gqlgen.UpdateTaskInput {
+	input := gqlgen.UpdateTaskInput{
+		Id:                 id,
+		Title:              update.Title,
+		Project:            update.Project,
+		EstimatedPomodoros: update.EstimatedPomodoros,
+		Notes:              update.Notes,
+	}
+
+	// A non-nil empty slice clears the tags, while null leaves them unchanged.
+	if update.Tags != nil {
+		input.Tags = *update.Tags
+		if input.Tags == nil {
+			input.Tags = []string{}
+		}
 	}
+
+	return input
 }
@@ -12,6 +12,212 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
+// ArchiveTaskArchiveTask includes the requested fields of the GraphQL type Task.
+type ArchiveTaskArchiveTask struct {
+	TaskDetails `json:"-"`
+}
+
+// GetId returns ArchiveTaskArchiveTask.Id, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetId() string { return v.TaskDetails.Id }
+
+// GetTitle returns ArchiveTaskArchiveTask.Title, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetTitle() string { return v.TaskDetails.Title }
+
+// GetProject returns ArchiveTaskArchiveTask.Project, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetProject() string { return v.TaskDetails.Project }
+
+// GetTags returns ArchiveTaskArchiveTask.Tags, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetTags() []string { return v.TaskDetails.Tags }
+
+// GetEstimatedPomodoros returns ArchiveTaskArchiveTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetEstimatedPomodoros() int { return v.TaskDetails.EstimatedPomodoros }
+
+// GetNotes returns ArchiveTaskArchiveTask.Notes, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetNotes() string { return v.TaskDetails.Notes }
+
+// GetStatus returns ArchiveTaskArchiveTask.Status, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
+
+// GetCreatedAt returns ArchiveTaskArchiveTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskArchiveTask) GetCreatedAt() time.Time { return v.TaskDetails.CreatedAt }
+
+func (v *ArchiveTaskArchiveTask) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ArchiveTaskArchiveTask
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ArchiveTaskArchiveTask = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TaskDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalArchiveTaskArchiveTask struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ArchiveTaskArchiveTask) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ArchiveTaskArchiveTask) __premarshalJSON() (*__premarshalArchiveTaskArchiveTask, error) {
+	var retval __premarshalArchiveTaskArchiveTask
+
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
+	return &retval, nil
+}
+
+// ArchiveTaskResponse is returned by ArchiveTask on success.
+type ArchiveTaskResponse struct {
+	ArchiveTask ArchiveTaskArchiveTask `json:"archiveTask"`
+}
+
+// GetArchiveTask returns ArchiveTaskResponse.ArchiveTask, and is useful for accessing the field via an interface.
+func (v *ArchiveTaskResponse) GetArchiveTask() ArchiveTaskArchiveTask { return v.ArchiveTask }
+
+// CompleteTaskCompleteTask includes the requested fields of the GraphQL type Task.
+type CompleteTaskCompleteTask struct {
+	TaskDetails `json:"-"`
+}
+
+// GetId returns CompleteTaskCompleteTask.Id, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetId() string { return v.TaskDetails.Id }
+
+// GetTitle returns CompleteTaskCompleteTask.Title, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetTitle() string { return v.TaskDetails.Title }
+
+// GetProject returns CompleteTaskCompleteTask.Project, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetProject() string { return v.TaskDetails.Project }
+
+// GetTags returns CompleteTaskCompleteTask.Tags, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetTags() []string { return v.TaskDetails.Tags }
+
+// GetEstimatedPomodoros returns CompleteTaskCompleteTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetEstimatedPomodoros() int {
+	return v.TaskDetails.EstimatedPomodoros
+}
+
+// GetNotes returns CompleteTaskCompleteTask.Notes, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetNotes() string { return v.TaskDetails.Notes }
+
+// GetStatus returns CompleteTaskCompleteTask.Status, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
+
+// GetCreatedAt returns CompleteTaskCompleteTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CompleteTaskCompleteTask) GetCreatedAt() time.Time { return v.TaskDetails.CreatedAt }
+
+func (v *CompleteTaskCompleteTask) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CompleteTaskCompleteTask
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CompleteTaskCompleteTask = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TaskDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCompleteTaskCompleteTask struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *CompleteTaskCompleteTask) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CompleteTaskCompleteTask) __premarshalJSON() (*__premarshalCompleteTaskCompleteTask, error) {
+	var retval __premarshalCompleteTaskCompleteTask
+
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
+	return &retval, nil
+}
+
+// CompleteTaskResponse is returned by CompleteTask on success.
+type CompleteTaskResponse struct {
+	CompleteTask CompleteTaskCompleteTask `json:"completeTask"`
+}
+
+// GetCompleteTask returns CompleteTaskResponse.CompleteTask, and is useful for accessing the field via an interface.
+func (v *CompleteTaskResponse) GetCompleteTask() CompleteTaskCompleteTask { return v.CompleteTask }
+
 // CreateTaskCreateTask includes the requested fields of the GraphQL type Task.
 type CreateTaskCreateTask struct {
 	TaskDetails `json:"-"`
@@ -23,6 +229,21 @@ func (v *CreateTaskCreateTask) GetId() string { return v.TaskDetails.Id }
 // GetTitle returns CreateTaskCreateTask.Title, and is useful for accessing the field via an interface.
 func (v *CreateTaskCreateTask) GetTitle() string { return v.TaskDetails.Title }
 
+// GetProject returns CreateTaskCreateTask.Project, and is useful for accessing the field via an interface.
+func (v *CreateTaskCreateTask) GetProject() string { return v.TaskDetails.Project }
+
+// GetTags returns CreateTaskCreateTask.Tags, and is useful for accessing the field via an interface.
+func (v *CreateTaskCreateTask) GetTags() []string { return v.TaskDetails.Tags }
+
+// GetEstimatedPomodoros returns CreateTaskCreateTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *CreateTaskCreateTask) GetEstimatedPomodoros() int { return v.TaskDetails.EstimatedPomodoros }
+
+// GetNotes returns CreateTaskCreateTask.Notes, and is useful for accessing the field via an interface.
+func (v *CreateTaskCreateTask) GetNotes() string { return v.TaskDetails.Notes }
+
+// GetStatus returns CreateTaskCreateTask.Status, and is useful for accessing the field via an interface.
+func (v *CreateTaskCreateTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
+
 // GetCreatedAt returns CreateTaskCreateTask.CreatedAt, and is useful for accessing the field via an interface.
 func (v *CreateTaskCreateTask) GetCreatedAt() time.Time { return v.TaskDetails.CreatedAt }
 
@@ -56,6 +277,16 @@ type __premarshalCreateTaskCreateTask struct {
 
 	Title string `json:"title"`
 
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
 
@@ -72,10 +303,38 @@ func (v *CreateTaskCreateTask) __premarshalJSON() (*__premarshalCreateTaskCreate
 
 	retval.Id = v.TaskDetails.Id
 	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
 	retval.CreatedAt = v.TaskDetails.CreatedAt
 	return &retval, nil
 }
 
+type CreateTaskInput struct {
+	Title              string   `json:"title"`
+	Project            string   `json:"project"`
+	Tags               []string `json:"tags"`
+	EstimatedPomodoros int      `json:"estimatedPomodoros"`
+	Notes              string   `json:"notes"`
+}
+
+// GetTitle returns CreateTaskInput.Title, and is useful for accessing the field via an interface.
+func (v *CreateTaskInput) GetTitle() string { return v.Title }
+
+// GetProject returns CreateTaskInput.Project, and is useful for accessing the field via an interface.
+func (v *CreateTaskInput) GetProject() string { return v.Project }
+
+// GetTags returns CreateTaskInput.Tags, and is useful for accessing the field via an interface.
+func (v *CreateTaskInput) GetTags() []string { return v.Tags }
+
+// GetEstimatedPomodoros returns CreateTaskInput.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *CreateTaskInput) GetEstimatedPomodoros() int { return v.EstimatedPomodoros }
+
+// GetNotes returns CreateTaskInput.Notes, and is useful for accessing the field via an interface.
+func (v *CreateTaskInput) GetNotes() string { return v.Notes }
+
 // CreateTaskResponse is returned by CreateTask on success.
 type CreateTaskResponse struct {
 	CreateTask CreateTaskCreateTask `json:"createTask"`
@@ -108,6 +367,7 @@ var AllEventCategory = []EventCategory{
 type EventDetails struct {
 	EventCategory EventCategory                   `json:"eventCategory"`
 	EventType     EventType                       `json:"eventType"`
+	Sequence      int                             `json:"sequence"`
 	Payload       EventDetailsPayloadEventPayload `json:"-"`
 }
 
@@ -117,6 +377,9 @@ func (v *EventDetails) GetEventCategory() EventCategory { return v.EventCategory
 // GetEventType returns EventDetails.EventType, and is useful for accessing the field via an interface.
 func (v *EventDetails) GetEventType() EventType { return v.EventType }
 
+// GetSequence returns EventDetails.Sequence, and is useful for accessing the field via an interface.
+func (v *EventDetails) GetSequence() int { return v.Sequence }
+
 // GetPayload returns EventDetails.Payload, and is useful for accessing the field via an interface.
 func (v *EventDetails) GetPayload() EventDetailsPayloadEventPayload { return v.Payload }
 
@@ -158,6 +421,8 @@ type __premarshalEventDetails struct {
 
 	EventType EventType `json:"eventType"`
 
+	Sequence int `json:"sequence"`
+
 	Payload json.RawMessage `json:"payload"`
 }
 
@@ -174,6 +439,7 @@ func (v *EventDetails) __premarshalJSON() (*__premarshalEventDetails, error) {
 
 	retval.EventCategory = v.EventCategory
 	retval.EventType = v.EventType
+	retval.Sequence = v.Sequence
 	{
 
 		dst := &retval.Payload
@@ -314,6 +580,16 @@ func (v *EventDetailsPayloadEventPomodoroPayload) GetPhaseCount() int {
 	return v.EventPomodoroPayloadDetails.PhaseCount
 }
 
+// GetPhaseDurationSec returns EventDetailsPayloadEventPomodoroPayload.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventPomodoroPayload) GetPhaseDurationSec() int {
+	return v.EventPomodoroPayloadDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns EventDetailsPayloadEventPomodoroPayload.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventPomodoroPayload) GetBreakFrequency() int {
+	return v.EventPomodoroPayloadDetails.BreakFrequency
+}
+
 func (v *EventDetailsPayloadEventPomodoroPayload) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -355,6 +631,10 @@ type __premarshalEventDetailsPayloadEventPomodoroPayload struct {
 	Phase PomodoroPhase `json:"phase"`
 
 	PhaseCount int `json:"phaseCount"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
 }
 
 func (v *EventDetailsPayloadEventPomodoroPayload) MarshalJSON() ([]byte, error) {
@@ -376,6 +656,8 @@ func (v *EventDetailsPayloadEventPomodoroPayload) __premarshalJSON() (*__premars
 	retval.TaskId = v.EventPomodoroPayloadDetails.TaskId
 	retval.Phase = v.EventPomodoroPayloadDetails.Phase
 	retval.PhaseCount = v.EventPomodoroPayloadDetails.PhaseCount
+	retval.PhaseDurationSec = v.EventPomodoroPayloadDetails.PhaseDurationSec
+	retval.BreakFrequency = v.EventPomodoroPayloadDetails.BreakFrequency
 	return &retval, nil
 }
 
@@ -396,6 +678,31 @@ func (v *EventDetailsPayloadEventTaskPayload) GetTitle() string {
 	return v.EventTaskPayloadDetails.Title
 }
 
+// GetProject returns EventDetailsPayloadEventTaskPayload.Project, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventTaskPayload) GetProject() string {
+	return v.EventTaskPayloadDetails.Project
+}
+
+// GetTags returns EventDetailsPayloadEventTaskPayload.Tags, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventTaskPayload) GetTags() []string {
+	return v.EventTaskPayloadDetails.Tags
+}
+
+// GetEstimatedPomodoros returns EventDetailsPayloadEventTaskPayload.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventTaskPayload) GetEstimatedPomodoros() int {
+	return v.EventTaskPayloadDetails.EstimatedPomodoros
+}
+
+// GetNotes returns EventDetailsPayloadEventTaskPayload.Notes, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventTaskPayload) GetNotes() string {
+	return v.EventTaskPayloadDetails.Notes
+}
+
+// GetStatus returns EventDetailsPayloadEventTaskPayload.Status, and is useful for accessing the field via an interface.
+func (v *EventDetailsPayloadEventTaskPayload) GetStatus() TaskStatus {
+	return v.EventTaskPayloadDetails.Status
+}
+
 func (v *EventDetailsPayloadEventTaskPayload) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -427,6 +734,16 @@ type __premarshalEventDetailsPayloadEventTaskPayload struct {
 	Id string `json:"id"`
 
 	Title string `json:"title"`
+
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
 }
 
 func (v *EventDetailsPayloadEventTaskPayload) MarshalJSON() ([]byte, error) {
@@ -443,6 +760,11 @@ func (v *EventDetailsPayloadEventTaskPayload) __premarshalJSON() (*__premarshalE
 	retval.Typename = v.Typename
 	retval.Id = v.EventTaskPayloadDetails.Id
 	retval.Title = v.EventTaskPayloadDetails.Title
+	retval.Project = v.EventTaskPayloadDetails.Project
+	retval.Tags = v.EventTaskPayloadDetails.Tags
+	retval.EstimatedPomodoros = v.EventTaskPayloadDetails.EstimatedPomodoros
+	retval.Notes = v.EventTaskPayloadDetails.Notes
+	retval.Status = v.EventTaskPayloadDetails.Status
 	return &retval, nil
 }
 
@@ -455,6 +777,8 @@ type EventPomodoroPayloadDetails struct {
 	TaskId           string        `json:"taskId"`
 	Phase            PomodoroPhase `json:"phase"`
 	PhaseCount       int           `json:"phaseCount"`
+	PhaseDurationSec int           `json:"phaseDurationSec"`
+	BreakFrequency   int           `json:"breakFrequency"`
 }
 
 // GetId returns EventPomodoroPayloadDetails.Id, and is useful for accessing the field via an interface.
@@ -478,17 +802,32 @@ func (v *EventPomodoroPayloadDetails) GetPhase() PomodoroPhase { return v.Phase
 // GetPhaseCount returns EventPomodoroPayloadDetails.PhaseCount, and is useful for accessing the field via an interface.
 func (v *EventPomodoroPayloadDetails) GetPhaseCount() int { return v.PhaseCount }
 
+// GetPhaseDurationSec returns EventPomodoroPayloadDetails.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *EventPomodoroPayloadDetails) GetPhaseDurationSec() int { return v.PhaseDurationSec }
+
+// GetBreakFrequency returns EventPomodoroPayloadDetails.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *EventPomodoroPayloadDetails) GetBreakFrequency() int { return v.BreakFrequency }
+
 type EventReceivedInput struct {
 	EventCategory []EventCategory `json:"eventCategory"`
+	SinceSequence *int            `json:"sinceSequence,omitempty"`
 }
 
 // GetEventCategory returns EventReceivedInput.EventCategory, and is useful for accessing the field via an interface.
 func (v *EventReceivedInput) GetEventCategory() []EventCategory { return v.EventCategory }
 
+// GetSinceSequence returns EventReceivedInput.SinceSequence, and is useful for accessing the field via an interface.
+func (v *EventReceivedInput) GetSinceSequence() *int { return v.SinceSequence }
+
 // EventTaskPayloadDetails includes the GraphQL fields of EventTaskPayload requested by the fragment EventTaskPayloadDetails.
 type EventTaskPayloadDetails struct {
-	Id    string `json:"id"`
-	Title string `json:"title"`
+	Id                 string     `json:"id"`
+	Title              string     `json:"title"`
+	Project            string     `json:"project"`
+	Tags               []string   `json:"tags"`
+	EstimatedPomodoros int        `json:"estimatedPomodoros"`
+	Notes              string     `json:"notes"`
+	Status             TaskStatus `json:"status"`
 }
 
 // GetId returns EventTaskPayloadDetails.Id, and is useful for accessing the field via an interface.
@@ -497,6 +836,21 @@ func (v *EventTaskPayloadDetails) GetId() string { return v.Id }
 // GetTitle returns EventTaskPayloadDetails.Title, and is useful for accessing the field via an interface.
 func (v *EventTaskPayloadDetails) GetTitle() string { return v.Title }
 
+// GetProject returns EventTaskPayloadDetails.Project, and is useful for accessing the field via an interface.
+func (v *EventTaskPayloadDetails) GetProject() string { return v.Project }
+
+// GetTags returns EventTaskPayloadDetails.Tags, and is useful for accessing the field via an interface.
+func (v *EventTaskPayloadDetails) GetTags() []string { return v.Tags }
+
+// GetEstimatedPomodoros returns EventTaskPayloadDetails.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *EventTaskPayloadDetails) GetEstimatedPomodoros() int { return v.EstimatedPomodoros }
+
+// GetNotes returns EventTaskPayloadDetails.Notes, and is useful for accessing the field via an interface.
+func (v *EventTaskPayloadDetails) GetNotes() string { return v.Notes }
+
+// GetStatus returns EventTaskPayloadDetails.Status, and is useful for accessing the field via an interface.
+func (v *EventTaskPayloadDetails) GetStatus() TaskStatus { return v.Status }
+
 type EventType string
 
 const (
@@ -509,6 +863,8 @@ const (
 	EventTypeTaskCreated       EventType = "TASK_CREATED"
 	EventTypeTaskUpdated       EventType = "TASK_UPDATED"
 	EventTypeTaskDeleted       EventType = "TASK_DELETED"
+	EventTypeTaskCompleted     EventType = "TASK_COMPLETED"
+	EventTypeTaskArchived      EventType = "TASK_ARCHIVED"
 )
 
 var AllEventType = []EventType{
@@ -521,6 +877,8 @@ var AllEventType = []EventType{
 	EventTypeTaskCreated,
 	EventTypeTaskUpdated,
 	EventTypeTaskDeleted,
+	EventTypeTaskCompleted,
+	EventTypeTaskArchived,
 }
 
 // GetAllTasksResponse is returned by GetAllTasks on success.
@@ -568,6 +926,31 @@ func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetTitle() string
 	return v.TaskDetails.Title
 }
 
+// GetProject returns GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Project, and is useful for accessing the field via an interface.
+func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetProject() string {
+	return v.TaskDetails.Project
+}
+
+// GetTags returns GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Tags, and is useful for accessing the field via an interface.
+func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetTags() []string {
+	return v.TaskDetails.Tags
+}
+
+// GetEstimatedPomodoros returns GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetEstimatedPomodoros() int {
+	return v.TaskDetails.EstimatedPomodoros
+}
+
+// GetNotes returns GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Notes, and is useful for accessing the field via an interface.
+func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetNotes() string {
+	return v.TaskDetails.Notes
+}
+
+// GetStatus returns GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Status, and is useful for accessing the field via an interface.
+func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetStatus() TaskStatus {
+	return v.TaskDetails.Status
+}
+
 // GetCreatedAt returns GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.CreatedAt, and is useful for accessing the field via an interface.
 func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetCreatedAt() time.Time {
 	return v.TaskDetails.CreatedAt
@@ -603,6 +986,16 @@ type __premarshalGetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask struct {
 
 	Title string `json:"title"`
 
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
 
@@ -619,6 +1012,11 @@ func (v *GetAllTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) __premarshalJSON()
 
 	retval.Id = v.TaskDetails.Id
 	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
 	retval.CreatedAt = v.TaskDetails.CreatedAt
 	return &retval, nil
 }
@@ -658,6 +1056,24 @@ func (v *GetCurrentPomodoroCurrentPomodoro) GetElapsedTimeSec() int {
 	return v.PomodoroDetails.ElapsedTimeSec
 }
 
+// GetPhaseDurationSec returns GetCurrentPomodoroCurrentPomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *GetCurrentPomodoroCurrentPomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns GetCurrentPomodoroCurrentPomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *GetCurrentPomodoroCurrentPomodoro) GetBreakFrequency() int {
+	return v.PomodoroDetails.BreakFrequency
+}
+
+// GetEndTime returns GetCurrentPomodoroCurrentPomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *GetCurrentPomodoroCurrentPomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns GetCurrentPomodoroCurrentPomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *GetCurrentPomodoroCurrentPomodoro) GetAutoAdvance() bool {
+	return v.PomodoroDetails.AutoAdvance
+}
+
 func (v *GetCurrentPomodoroCurrentPomodoro) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -699,6 +1115,14 @@ type __premarshalGetCurrentPomodoroCurrentPomodoro struct {
 	RemainingTimeSec int `json:"remainingTimeSec"`
 
 	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
 }
 
 func (v *GetCurrentPomodoroCurrentPomodoro) MarshalJSON() ([]byte, error) {
@@ -720,6 +1144,10 @@ func (v *GetCurrentPomodoroCurrentPomodoro) __premarshalJSON() (*__premarshalGet
 	retval.PhaseCount = v.PomodoroDetails.PhaseCount
 	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
 	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
 	return &retval, nil
 }
 
@@ -733,39 +1161,74 @@ func (v *GetCurrentPomodoroResponse) GetCurrentPomodoro() GetCurrentPomodoroCurr
 	return v.CurrentPomodoro
 }
 
-// GetTaskResponse is returned by GetTask on success.
-type GetTaskResponse struct {
-	Task GetTaskTask `json:"task"`
+// GetCurrentStatusCurrentPomodoro includes the requested fields of the GraphQL type Pomodoro.
+type GetCurrentStatusCurrentPomodoro struct {
+	PomodoroDetails `json:"-"`
+	Task            *GetCurrentStatusCurrentPomodoroTask `json:"task"`
 }
 
-// GetTask returns GetTaskResponse.Task, and is useful for accessing the field via an interface.
-func (v *GetTaskResponse) GetTask() GetTaskTask { return v.Task }
+// GetTask returns GetCurrentStatusCurrentPomodoro.Task, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetTask() *GetCurrentStatusCurrentPomodoroTask {
+	return v.Task
+}
 
-// GetTaskTask includes the requested fields of the GraphQL type Task.
-type GetTaskTask struct {
-	TaskDetails `json:"-"`
+// GetId returns GetCurrentStatusCurrentPomodoro.Id, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetId() string { return v.PomodoroDetails.Id }
+
+// GetState returns GetCurrentStatusCurrentPomodoro.State, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+
+// GetTaskId returns GetCurrentStatusCurrentPomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+
+// GetStartTime returns GetCurrentStatusCurrentPomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetStartTime() time.Time {
+	return v.PomodoroDetails.StartTime
 }
 
-// GetId returns GetTaskTask.Id, and is useful for accessing the field via an interface.
-func (v *GetTaskTask) GetId() string { return v.TaskDetails.Id }
+// GetPhase returns GetCurrentStatusCurrentPomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
 
-// GetTitle returns GetTaskTask.Title, and is useful for accessing the field via an interface.
-func (v *GetTaskTask) GetTitle() string { return v.TaskDetails.Title }
+// GetPhaseCount returns GetCurrentStatusCurrentPomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
 
-// GetCreatedAt returns GetTaskTask.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetTaskTask) GetCreatedAt() time.Time { return v.TaskDetails.CreatedAt }
+// GetRemainingTimeSec returns GetCurrentStatusCurrentPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetRemainingTimeSec() int {
+	return v.PomodoroDetails.RemainingTimeSec
+}
 
-func (v *GetTaskTask) UnmarshalJSON(b []byte) error {
+// GetElapsedTimeSec returns GetCurrentStatusCurrentPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetElapsedTimeSec() int {
+	return v.PomodoroDetails.ElapsedTimeSec
+}
+
+// GetPhaseDurationSec returns GetCurrentStatusCurrentPomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns GetCurrentStatusCurrentPomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetBreakFrequency() int {
+	return v.PomodoroDetails.BreakFrequency
+}
+
+// GetEndTime returns GetCurrentStatusCurrentPomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns GetCurrentStatusCurrentPomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoro) GetAutoAdvance() bool { return v.PomodoroDetails.AutoAdvance }
+
+func (v *GetCurrentStatusCurrentPomodoro) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetTaskTask
+		*GetCurrentStatusCurrentPomodoro
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetTaskTask = v
+	firstPass.GetCurrentStatusCurrentPomodoro = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -773,67 +1236,112 @@ func (v *GetTaskTask) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.TaskDetails)
+		b, &v.PomodoroDetails)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalGetTaskTask struct {
+type __premarshalGetCurrentStatusCurrentPomodoro struct {
+	Task *GetCurrentStatusCurrentPomodoroTask `json:"task"`
+
 	Id string `json:"id"`
 
-	Title string `json:"title"`
+	State PomodoroState `json:"state"`
 
-	CreatedAt time.Time `json:"createdAt"`
-}
+	TaskId string `json:"taskId"`
 
-func (v *GetTaskTask) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+	StartTime time.Time `json:"startTime"`
 
-func (v *GetTaskTask) __premarshalJSON() (*__premarshalGetTaskTask, error) {
-	var retval __premarshalGetTaskTask
+	Phase PomodoroPhase `json:"phase"`
 
-	retval.Id = v.TaskDetails.Id
-	retval.Title = v.TaskDetails.Title
-	retval.CreatedAt = v.TaskDetails.CreatedAt
+	PhaseCount int `json:"phaseCount"`
+
+	RemainingTimeSec int `json:"remainingTimeSec"`
+
+	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
+}
+
+func (v *GetCurrentStatusCurrentPomodoro) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetCurrentStatusCurrentPomodoro) __premarshalJSON() (*__premarshalGetCurrentStatusCurrentPomodoro, error) {
+	var retval __premarshalGetCurrentStatusCurrentPomodoro
+
+	retval.Task = v.Task
+	retval.Id = v.PomodoroDetails.Id
+	retval.State = v.PomodoroDetails.State
+	retval.TaskId = v.PomodoroDetails.TaskId
+	retval.StartTime = v.PomodoroDetails.StartTime
+	retval.Phase = v.PomodoroDetails.Phase
+	retval.PhaseCount = v.PomodoroDetails.PhaseCount
+	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
+	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
 	return &retval, nil
 }
 
-// OnEventReceivedEventReceivedEvent includes the requested fields of the GraphQL type Event.
-type OnEventReceivedEventReceivedEvent struct {
-	EventDetails `json:"-"`
+// GetCurrentStatusCurrentPomodoroTask includes the requested fields of the GraphQL type Task.
+type GetCurrentStatusCurrentPomodoroTask struct {
+	TaskDetails `json:"-"`
 }
 
-// GetEventCategory returns OnEventReceivedEventReceivedEvent.EventCategory, and is useful for accessing the field via an interface.
-func (v *OnEventReceivedEventReceivedEvent) GetEventCategory() EventCategory {
-	return v.EventDetails.EventCategory
+// GetId returns GetCurrentStatusCurrentPomodoroTask.Id, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetId() string { return v.TaskDetails.Id }
+
+// GetTitle returns GetCurrentStatusCurrentPomodoroTask.Title, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetTitle() string { return v.TaskDetails.Title }
+
+// GetProject returns GetCurrentStatusCurrentPomodoroTask.Project, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetProject() string { return v.TaskDetails.Project }
+
+// GetTags returns GetCurrentStatusCurrentPomodoroTask.Tags, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetTags() []string { return v.TaskDetails.Tags }
+
+// GetEstimatedPomodoros returns GetCurrentStatusCurrentPomodoroTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetEstimatedPomodoros() int {
+	return v.TaskDetails.EstimatedPomodoros
 }
 
-// GetEventType returns OnEventReceivedEventReceivedEvent.EventType, and is useful for accessing the field via an interface.
-func (v *OnEventReceivedEventReceivedEvent) GetEventType() EventType { return v.EventDetails.EventType }
+// GetNotes returns GetCurrentStatusCurrentPomodoroTask.Notes, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetNotes() string { return v.TaskDetails.Notes }
 
-// GetPayload returns OnEventReceivedEventReceivedEvent.Payload, and is useful for accessing the field via an interface.
-func (v *OnEventReceivedEventReceivedEvent) GetPayload() EventDetailsPayloadEventPayload {
-	return v.EventDetails.Payload
+// GetStatus returns GetCurrentStatusCurrentPomodoroTask.Status, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
+
+// GetCreatedAt returns GetCurrentStatusCurrentPomodoroTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusCurrentPomodoroTask) GetCreatedAt() time.Time {
+	return v.TaskDetails.CreatedAt
 }
 
-func (v *OnEventReceivedEventReceivedEvent) UnmarshalJSON(b []byte) error {
+func (v *GetCurrentStatusCurrentPomodoroTask) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*OnEventReceivedEventReceivedEvent
+		*GetCurrentStatusCurrentPomodoroTask
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.OnEventReceivedEventReceivedEvent = v
+	firstPass.GetCurrentStatusCurrentPomodoroTask = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -841,22 +1349,32 @@ func (v *OnEventReceivedEventReceivedEvent) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.EventDetails)
+		b, &v.TaskDetails)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalOnEventReceivedEventReceivedEvent struct {
-	EventCategory EventCategory `json:"eventCategory"`
+type __premarshalGetCurrentStatusCurrentPomodoroTask struct {
+	Id string `json:"id"`
 
-	EventType EventType `json:"eventType"`
+	Title string `json:"title"`
 
-	Payload json.RawMessage `json:"payload"`
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
 }
 
-func (v *OnEventReceivedEventReceivedEvent) MarshalJSON() ([]byte, error) {
+func (v *GetCurrentStatusCurrentPomodoroTask) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -864,78 +1382,136 @@ func (v *OnEventReceivedEventReceivedEvent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *OnEventReceivedEventReceivedEvent) __premarshalJSON() (*__premarshalOnEventReceivedEventReceivedEvent, error) {
-	var retval __premarshalOnEventReceivedEventReceivedEvent
-
-	retval.EventCategory = v.EventDetails.EventCategory
-	retval.EventType = v.EventDetails.EventType
-	{
+func (v *GetCurrentStatusCurrentPomodoroTask) __premarshalJSON() (*__premarshalGetCurrentStatusCurrentPomodoroTask, error) {
+	var retval __premarshalGetCurrentStatusCurrentPomodoroTask
 
-		dst := &retval.Payload
-		src := v.EventDetails.Payload
-		var err error
-		*dst, err = __marshalEventDetailsPayloadEventPayload(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal OnEventReceivedEventReceivedEvent.EventDetails.Payload: %w", err)
-		}
-	}
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
 	return &retval, nil
 }
 
-// OnEventReceivedResponse is returned by OnEventReceived on success.
-type OnEventReceivedResponse struct {
-	EventReceived OnEventReceivedEventReceivedEvent `json:"eventReceived"`
+// GetCurrentStatusResponse is returned by GetCurrentStatus on success.
+type GetCurrentStatusResponse struct {
+	CurrentPomodoro GetCurrentStatusCurrentPomodoro `json:"currentPomodoro"`
 }
 
-// GetEventReceived returns OnEventReceivedResponse.EventReceived, and is useful for accessing the field via an interface.
-func (v *OnEventReceivedResponse) GetEventReceived() OnEventReceivedEventReceivedEvent {
-	return v.EventReceived
+// GetCurrentPomodoro returns GetCurrentStatusResponse.CurrentPomodoro, and is useful for accessing the field via an interface.
+func (v *GetCurrentStatusResponse) GetCurrentPomodoro() GetCurrentStatusCurrentPomodoro {
+	return v.CurrentPomodoro
 }
 
-// PausePomodoroPausePomodoro includes the requested fields of the GraphQL type Pomodoro.
-type PausePomodoroPausePomodoro struct {
+// GetPomodoroHistoryPomodorosPomodoroConnection includes the requested fields of the GraphQL type PomodoroConnection.
+type GetPomodoroHistoryPomodorosPomodoroConnection struct {
+	TotalCount int                                                              `json:"totalCount"`
+	PageInfo   GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo            `json:"pageInfo"`
+	Edges      []GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdge `json:"edges"`
+}
+
+// GetTotalCount returns GetPomodoroHistoryPomodorosPomodoroConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetPageInfo returns GetPomodoroHistoryPomodorosPomodoroConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnection) GetPageInfo() GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns GetPomodoroHistoryPomodorosPomodoroConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnection) GetEdges() []GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdge {
+	return v.Edges
+}
+
+// GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdge includes the requested fields of the GraphQL type PomodoroEdge.
+type GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdge struct {
+	Node GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro `json:"node"`
+}
+
+// GetNode returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdge) GetNode() GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro {
+	return v.Node
+}
+
+// GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro includes the requested fields of the GraphQL type Pomodoro.
+type GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro struct {
 	PomodoroDetails `json:"-"`
 }
 
-// GetId returns PausePomodoroPausePomodoro.Id, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetId() string { return v.PomodoroDetails.Id }
+// GetId returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.Id, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetId() string {
+	return v.PomodoroDetails.Id
+}
 
-// GetState returns PausePomodoroPausePomodoro.State, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+// GetState returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.State, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetState() PomodoroState {
+	return v.PomodoroDetails.State
+}
 
-// GetTaskId returns PausePomodoroPausePomodoro.TaskId, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+// GetTaskId returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetTaskId() string {
+	return v.PomodoroDetails.TaskId
+}
 
-// GetStartTime returns PausePomodoroPausePomodoro.StartTime, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+// GetStartTime returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetStartTime() time.Time {
+	return v.PomodoroDetails.StartTime
+}
 
-// GetPhase returns PausePomodoroPausePomodoro.Phase, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+// GetPhase returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetPhase() PomodoroPhase {
+	return v.PomodoroDetails.Phase
+}
 
-// GetPhaseCount returns PausePomodoroPausePomodoro.PhaseCount, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+// GetPhaseCount returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetPhaseCount() int {
+	return v.PomodoroDetails.PhaseCount
+}
 
-// GetRemainingTimeSec returns PausePomodoroPausePomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetRemainingTimeSec() int {
+// GetRemainingTimeSec returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetRemainingTimeSec() int {
 	return v.PomodoroDetails.RemainingTimeSec
 }
 
-// GetElapsedTimeSec returns PausePomodoroPausePomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
-func (v *PausePomodoroPausePomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+// GetElapsedTimeSec returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetElapsedTimeSec() int {
+	return v.PomodoroDetails.ElapsedTimeSec
+}
 
-func (v *PausePomodoroPausePomodoro) UnmarshalJSON(b []byte) error {
+// GetPhaseDurationSec returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetBreakFrequency() int {
+	return v.PomodoroDetails.BreakFrequency
+}
+
+// GetEndTime returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetEndTime() time.Time {
+	return v.PomodoroDetails.EndTime
+}
+
+// GetAutoAdvance returns GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) GetAutoAdvance() bool {
+	return v.PomodoroDetails.AutoAdvance
+}
+
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*PausePomodoroPausePomodoro
+		*GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.PausePomodoroPausePomodoro = v
+	firstPass.GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -950,7 +1526,7 @@ func (v *PausePomodoroPausePomodoro) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-type __premarshalPausePomodoroPausePomodoro struct {
+type __premarshalGetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro struct {
 	Id string `json:"id"`
 
 	State PomodoroState `json:"state"`
@@ -966,9 +1542,17 @@ type __premarshalPausePomodoroPausePomodoro struct {
 	RemainingTimeSec int `json:"remainingTimeSec"`
 
 	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
 }
 
-func (v *PausePomodoroPausePomodoro) MarshalJSON() ([]byte, error) {
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -976,8 +1560,8 @@ func (v *PausePomodoroPausePomodoro) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *PausePomodoroPausePomodoro) __premarshalJSON() (*__premarshalPausePomodoroPausePomodoro, error) {
-	var retval __premarshalPausePomodoroPausePomodoro
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro) __premarshalJSON() (*__premarshalGetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro, error) {
+	var retval __premarshalGetPomodoroHistoryPomodorosPomodoroConnectionEdgesPomodoroEdgeNodePomodoro
 
 	retval.Id = v.PomodoroDetails.Id
 	retval.State = v.PomodoroDetails.State
@@ -987,123 +1571,84 @@ func (v *PausePomodoroPausePomodoro) __premarshalJSON() (*__premarshalPausePomod
 	retval.PhaseCount = v.PomodoroDetails.PhaseCount
 	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
 	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
 	return &retval, nil
 }
 
-// PausePomodoroResponse is returned by PausePomodoro on success.
-type PausePomodoroResponse struct {
-	PausePomodoro PausePomodoroPausePomodoro `json:"pausePomodoro"`
+// GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
 }
 
-// GetPausePomodoro returns PausePomodoroResponse.PausePomodoro, and is useful for accessing the field via an interface.
-func (v *PausePomodoroResponse) GetPausePomodoro() PausePomodoroPausePomodoro { return v.PausePomodoro }
-
-// PomodoroDetails includes the GraphQL fields of Pomodoro requested by the fragment PomodoroDetails.
-type PomodoroDetails struct {
-	Id               string        `json:"id"`
-	State            PomodoroState `json:"state"`
-	TaskId           string        `json:"taskId"`
-	StartTime        time.Time     `json:"startTime"`
-	Phase            PomodoroPhase `json:"phase"`
-	PhaseCount       int           `json:"phaseCount"`
-	RemainingTimeSec int           `json:"remainingTimeSec"`
-	ElapsedTimeSec   int           `json:"elapsedTimeSec"`
+// GetHasNextPage returns GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
 }
 
-// GetId returns PomodoroDetails.Id, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetId() string { return v.Id }
-
-// GetState returns PomodoroDetails.State, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetState() PomodoroState { return v.State }
-
-// GetTaskId returns PomodoroDetails.TaskId, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetTaskId() string { return v.TaskId }
-
-// GetStartTime returns PomodoroDetails.StartTime, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetStartTime() time.Time { return v.StartTime }
-
-// GetPhase returns PomodoroDetails.Phase, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetPhase() PomodoroPhase { return v.Phase }
-
-// GetPhaseCount returns PomodoroDetails.PhaseCount, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetPhaseCount() int { return v.PhaseCount }
-
-// GetRemainingTimeSec returns PomodoroDetails.RemainingTimeSec, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetRemainingTimeSec() int { return v.RemainingTimeSec }
-
-// GetElapsedTimeSec returns PomodoroDetails.ElapsedTimeSec, and is useful for accessing the field via an interface.
-func (v *PomodoroDetails) GetElapsedTimeSec() int { return v.ElapsedTimeSec }
-
-type PomodoroPhase string
-
-const (
-	PomodoroPhaseWork       PomodoroPhase = "WORK"
-	PomodoroPhaseShortBreak PomodoroPhase = "SHORT_BREAK"
-	PomodoroPhaseLongBreak  PomodoroPhase = "LONG_BREAK"
-)
-
-var AllPomodoroPhase = []PomodoroPhase{
-	PomodoroPhaseWork,
-	PomodoroPhaseShortBreak,
-	PomodoroPhaseLongBreak,
+// GetEndCursor returns GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryPomodorosPomodoroConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
 }
 
-type PomodoroState string
-
-const (
-	PomodoroStateActive   PomodoroState = "ACTIVE"
-	PomodoroStatePaused   PomodoroState = "PAUSED"
-	PomodoroStateFinished PomodoroState = "FINISHED"
-)
+// GetPomodoroHistoryResponse is returned by GetPomodoroHistory on success.
+type GetPomodoroHistoryResponse struct {
+	Pomodoros GetPomodoroHistoryPomodorosPomodoroConnection `json:"pomodoros"`
+}
 
-var AllPomodoroState = []PomodoroState{
-	PomodoroStateActive,
-	PomodoroStatePaused,
-	PomodoroStateFinished,
+// GetPomodoros returns GetPomodoroHistoryResponse.Pomodoros, and is useful for accessing the field via an interface.
+func (v *GetPomodoroHistoryResponse) GetPomodoros() GetPomodoroHistoryPomodorosPomodoroConnection {
+	return v.Pomodoros
 }
 
-// ResetPomodoroResetPomodoro includes the requested fields of the GraphQL type Pomodoro.
-type ResetPomodoroResetPomodoro struct {
-	PomodoroDetails `json:"-"`
+// GetStatsResponse is returned by GetStats on success.
+type GetStatsResponse struct {
+	Stats GetStatsStats `json:"stats"`
 }
 
-// GetId returns ResetPomodoroResetPomodoro.Id, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetId() string { return v.PomodoroDetails.Id }
+// GetStats returns GetStatsResponse.Stats, and is useful for accessing the field via an interface.
+func (v *GetStatsResponse) GetStats() GetStatsStats { return v.Stats }
 
-// GetState returns ResetPomodoroResetPomodoro.State, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+// GetStatsStats includes the requested fields of the GraphQL type Stats.
+type GetStatsStats struct {
+	StatsDetails `json:"-"`
+}
 
-// GetTaskId returns ResetPomodoroResetPomodoro.TaskId, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+// GetStart returns GetStatsStats.Start, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetStart() time.Time { return v.StatsDetails.Start }
 
-// GetStartTime returns ResetPomodoroResetPomodoro.StartTime, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+// GetEnd returns GetStatsStats.End, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetEnd() time.Time { return v.StatsDetails.End }
 
-// GetPhase returns ResetPomodoroResetPomodoro.Phase, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+// GetFocusTimeSec returns GetStatsStats.FocusTimeSec, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetFocusTimeSec() int { return v.StatsDetails.FocusTimeSec }
 
-// GetPhaseCount returns ResetPomodoroResetPomodoro.PhaseCount, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+// GetSessions returns GetStatsStats.Sessions, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetSessions() int { return v.StatsDetails.Sessions }
 
-// GetRemainingTimeSec returns ResetPomodoroResetPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetRemainingTimeSec() int {
-	return v.PomodoroDetails.RemainingTimeSec
-}
+// GetInterruptions returns GetStatsStats.Interruptions, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetInterruptions() int { return v.StatsDetails.Interruptions }
 
-// GetElapsedTimeSec returns ResetPomodoroResetPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResetPomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+// GetBreakAdherence returns GetStatsStats.BreakAdherence, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetBreakAdherence() *float64 { return v.StatsDetails.BreakAdherence }
 
-func (v *ResetPomodoroResetPomodoro) UnmarshalJSON(b []byte) error {
+// GetGroups returns GetStatsStats.Groups, and is useful for accessing the field via an interface.
+func (v *GetStatsStats) GetGroups() []StatsDetailsGroupsStatsGroup { return v.StatsDetails.Groups }
+
+func (v *GetStatsStats) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ResetPomodoroResetPomodoro
+		*GetStatsStats
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ResetPomodoroResetPomodoro = v
+	firstPass.GetStatsStats = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1111,32 +1656,30 @@ func (v *ResetPomodoroResetPomodoro) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.PomodoroDetails)
+		b, &v.StatsDetails)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalResetPomodoroResetPomodoro struct {
-	Id string `json:"id"`
-
-	State PomodoroState `json:"state"`
+type __premarshalGetStatsStats struct {
+	Start time.Time `json:"start"`
 
-	TaskId string `json:"taskId"`
+	End time.Time `json:"end"`
 
-	StartTime time.Time `json:"startTime"`
+	FocusTimeSec int `json:"focusTimeSec"`
 
-	Phase PomodoroPhase `json:"phase"`
+	Sessions int `json:"sessions"`
 
-	PhaseCount int `json:"phaseCount"`
+	Interruptions int `json:"interruptions"`
 
-	RemainingTimeSec int `json:"remainingTimeSec"`
+	BreakAdherence *float64 `json:"breakAdherence"`
 
-	ElapsedTimeSec int `json:"elapsedTimeSec"`
+	Groups []StatsDetailsGroupsStatsGroup `json:"groups"`
 }
 
-func (v *ResetPomodoroResetPomodoro) MarshalJSON() ([]byte, error) {
+func (v *GetStatsStats) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1144,82 +1687,1261 @@ func (v *ResetPomodoroResetPomodoro) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *ResetPomodoroResetPomodoro) __premarshalJSON() (*__premarshalResetPomodoroResetPomodoro, error) {
-	var retval __premarshalResetPomodoroResetPomodoro
+func (v *GetStatsStats) __premarshalJSON() (*__premarshalGetStatsStats, error) {
+	var retval __premarshalGetStatsStats
 
-	retval.Id = v.PomodoroDetails.Id
-	retval.State = v.PomodoroDetails.State
-	retval.TaskId = v.PomodoroDetails.TaskId
+	retval.Start = v.StatsDetails.Start
+	retval.End = v.StatsDetails.End
+	retval.FocusTimeSec = v.StatsDetails.FocusTimeSec
+	retval.Sessions = v.StatsDetails.Sessions
+	retval.Interruptions = v.StatsDetails.Interruptions
+	retval.BreakAdherence = v.StatsDetails.BreakAdherence
+	retval.Groups = v.StatsDetails.Groups
+	return &retval, nil
+}
+
+// GetTaskResponse is returned by GetTask on success.
+type GetTaskResponse struct {
+	Task GetTaskTask `json:"task"`
+}
+
+// GetTask returns GetTaskResponse.Task, and is useful for accessing the field via an interface.
+func (v *GetTaskResponse) GetTask() GetTaskTask { return v.Task }
+
+// GetTaskTask includes the requested fields of the GraphQL type Task.
+type GetTaskTask struct {
+	TaskDetails `json:"-"`
+}
+
+// GetId returns GetTaskTask.Id, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetId() string { return v.TaskDetails.Id }
+
+// GetTitle returns GetTaskTask.Title, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetTitle() string { return v.TaskDetails.Title }
+
+// GetProject returns GetTaskTask.Project, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetProject() string { return v.TaskDetails.Project }
+
+// GetTags returns GetTaskTask.Tags, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetTags() []string { return v.TaskDetails.Tags }
+
+// GetEstimatedPomodoros returns GetTaskTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetEstimatedPomodoros() int { return v.TaskDetails.EstimatedPomodoros }
+
+// GetNotes returns GetTaskTask.Notes, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetNotes() string { return v.TaskDetails.Notes }
+
+// GetStatus returns GetTaskTask.Status, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
+
+// GetCreatedAt returns GetTaskTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetTaskTask) GetCreatedAt() time.Time { return v.TaskDetails.CreatedAt }
+
+func (v *GetTaskTask) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetTaskTask
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetTaskTask = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TaskDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetTaskTask struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *GetTaskTask) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetTaskTask) __premarshalJSON() (*__premarshalGetTaskTask, error) {
+	var retval __premarshalGetTaskTask
+
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
+	return &retval, nil
+}
+
+// ListTasksResponse is returned by ListTasks on success.
+type ListTasksResponse struct {
+	Tasks ListTasksTasksTaskConnection `json:"tasks"`
+}
+
+// GetTasks returns ListTasksResponse.Tasks, and is useful for accessing the field via an interface.
+func (v *ListTasksResponse) GetTasks() ListTasksTasksTaskConnection { return v.Tasks }
+
+// ListTasksTasksTaskConnection includes the requested fields of the GraphQL type TaskConnection.
+type ListTasksTasksTaskConnection struct {
+	TotalCount int                                         `json:"totalCount"`
+	Edges      []ListTasksTasksTaskConnectionEdgesTaskEdge `json:"edges"`
+}
+
+// GetTotalCount returns ListTasksTasksTaskConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnection) GetTotalCount() int { return v.TotalCount }
+
+// GetEdges returns ListTasksTasksTaskConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnection) GetEdges() []ListTasksTasksTaskConnectionEdgesTaskEdge {
+	return v.Edges
+}
+
+// ListTasksTasksTaskConnectionEdgesTaskEdge includes the requested fields of the GraphQL type TaskEdge.
+type ListTasksTasksTaskConnectionEdgesTaskEdge struct {
+	Node ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask `json:"node"`
+}
+
+// GetNode returns ListTasksTasksTaskConnectionEdgesTaskEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdge) GetNode() ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask {
+	return v.Node
+}
+
+// ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask includes the requested fields of the GraphQL type Task.
+type ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask struct {
+	TaskDetails `json:"-"`
+}
+
+// GetId returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Id, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetId() string { return v.TaskDetails.Id }
+
+// GetTitle returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Title, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetTitle() string {
+	return v.TaskDetails.Title
+}
+
+// GetProject returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Project, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetProject() string {
+	return v.TaskDetails.Project
+}
+
+// GetTags returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Tags, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetTags() []string {
+	return v.TaskDetails.Tags
+}
+
+// GetEstimatedPomodoros returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetEstimatedPomodoros() int {
+	return v.TaskDetails.EstimatedPomodoros
+}
+
+// GetNotes returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Notes, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetNotes() string {
+	return v.TaskDetails.Notes
+}
+
+// GetStatus returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.Status, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetStatus() TaskStatus {
+	return v.TaskDetails.Status
+}
+
+// GetCreatedAt returns ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) GetCreatedAt() time.Time {
+	return v.TaskDetails.CreatedAt
+}
+
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TaskDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Project string `json:"project"`
+
+	Tags []string `json:"tags"`
+
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
+
+	Notes string `json:"notes"`
+
+	Status TaskStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask) __premarshalJSON() (*__premarshalListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask, error) {
+	var retval __premarshalListTasksTasksTaskConnectionEdgesTaskEdgeNodeTask
+
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
+	return &retval, nil
+}
+
+// OnEventReceivedEventReceivedEvent includes the requested fields of the GraphQL type Event.
+type OnEventReceivedEventReceivedEvent struct {
+	EventDetails `json:"-"`
+}
+
+// GetEventCategory returns OnEventReceivedEventReceivedEvent.EventCategory, and is useful for accessing the field via an interface.
+func (v *OnEventReceivedEventReceivedEvent) GetEventCategory() EventCategory {
+	return v.EventDetails.EventCategory
+}
+
+// GetEventType returns OnEventReceivedEventReceivedEvent.EventType, and is useful for accessing the field via an interface.
+func (v *OnEventReceivedEventReceivedEvent) GetEventType() EventType { return v.EventDetails.EventType }
+
+// GetSequence returns OnEventReceivedEventReceivedEvent.Sequence, and is useful for accessing the field via an interface.
+func (v *OnEventReceivedEventReceivedEvent) GetSequence() int { return v.EventDetails.Sequence }
+
+// GetPayload returns OnEventReceivedEventReceivedEvent.Payload, and is useful for accessing the field via an interface.
+func (v *OnEventReceivedEventReceivedEvent) GetPayload() EventDetailsPayloadEventPayload {
+	return v.EventDetails.Payload
+}
+
+func (v *OnEventReceivedEventReceivedEvent) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OnEventReceivedEventReceivedEvent
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OnEventReceivedEventReceivedEvent = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EventDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOnEventReceivedEventReceivedEvent struct {
+	EventCategory EventCategory `json:"eventCategory"`
+
+	EventType EventType `json:"eventType"`
+
+	Sequence int `json:"sequence"`
+
+	Payload json.RawMessage `json:"payload"`
+}
+
+func (v *OnEventReceivedEventReceivedEvent) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OnEventReceivedEventReceivedEvent) __premarshalJSON() (*__premarshalOnEventReceivedEventReceivedEvent, error) {
+	var retval __premarshalOnEventReceivedEventReceivedEvent
+
+	retval.EventCategory = v.EventDetails.EventCategory
+	retval.EventType = v.EventDetails.EventType
+	retval.Sequence = v.EventDetails.Sequence
+	{
+
+		dst := &retval.Payload
+		src := v.EventDetails.Payload
+		var err error
+		*dst, err = __marshalEventDetailsPayloadEventPayload(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal OnEventReceivedEventReceivedEvent.EventDetails.Payload: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// OnEventReceivedResponse is returned by OnEventReceived on success.
+type OnEventReceivedResponse struct {
+	EventReceived OnEventReceivedEventReceivedEvent `json:"eventReceived"`
+}
+
+// GetEventReceived returns OnEventReceivedResponse.EventReceived, and is useful for accessing the field via an interface.
+func (v *OnEventReceivedResponse) GetEventReceived() OnEventReceivedEventReceivedEvent {
+	return v.EventReceived
+}
+
+// PausePomodoroPausePomodoro includes the requested fields of the GraphQL type Pomodoro.
+type PausePomodoroPausePomodoro struct {
+	PomodoroDetails `json:"-"`
+}
+
+// GetId returns PausePomodoroPausePomodoro.Id, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetId() string { return v.PomodoroDetails.Id }
+
+// GetState returns PausePomodoroPausePomodoro.State, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+
+// GetTaskId returns PausePomodoroPausePomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+
+// GetStartTime returns PausePomodoroPausePomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+
+// GetPhase returns PausePomodoroPausePomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+
+// GetPhaseCount returns PausePomodoroPausePomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+
+// GetRemainingTimeSec returns PausePomodoroPausePomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetRemainingTimeSec() int {
+	return v.PomodoroDetails.RemainingTimeSec
+}
+
+// GetElapsedTimeSec returns PausePomodoroPausePomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+
+// GetPhaseDurationSec returns PausePomodoroPausePomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns PausePomodoroPausePomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetBreakFrequency() int { return v.PomodoroDetails.BreakFrequency }
+
+// GetEndTime returns PausePomodoroPausePomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns PausePomodoroPausePomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *PausePomodoroPausePomodoro) GetAutoAdvance() bool { return v.PomodoroDetails.AutoAdvance }
+
+func (v *PausePomodoroPausePomodoro) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PausePomodoroPausePomodoro
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PausePomodoroPausePomodoro = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PomodoroDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPausePomodoroPausePomodoro struct {
+	Id string `json:"id"`
+
+	State PomodoroState `json:"state"`
+
+	TaskId string `json:"taskId"`
+
+	StartTime time.Time `json:"startTime"`
+
+	Phase PomodoroPhase `json:"phase"`
+
+	PhaseCount int `json:"phaseCount"`
+
+	RemainingTimeSec int `json:"remainingTimeSec"`
+
+	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
+}
+
+func (v *PausePomodoroPausePomodoro) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PausePomodoroPausePomodoro) __premarshalJSON() (*__premarshalPausePomodoroPausePomodoro, error) {
+	var retval __premarshalPausePomodoroPausePomodoro
+
+	retval.Id = v.PomodoroDetails.Id
+	retval.State = v.PomodoroDetails.State
+	retval.TaskId = v.PomodoroDetails.TaskId
+	retval.StartTime = v.PomodoroDetails.StartTime
+	retval.Phase = v.PomodoroDetails.Phase
+	retval.PhaseCount = v.PomodoroDetails.PhaseCount
+	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
+	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
+	return &retval, nil
+}
+
+// PausePomodoroResponse is returned by PausePomodoro on success.
+type PausePomodoroResponse struct {
+	PausePomodoro PausePomodoroPausePomodoro `json:"pausePomodoro"`
+}
+
+// GetPausePomodoro returns PausePomodoroResponse.PausePomodoro, and is useful for accessing the field via an interface.
+func (v *PausePomodoroResponse) GetPausePomodoro() PausePomodoroPausePomodoro { return v.PausePomodoro }
+
+// PomodoroDetails includes the GraphQL fields of Pomodoro requested by the fragment PomodoroDetails.
+type PomodoroDetails struct {
+	Id               string        `json:"id"`
+	State            PomodoroState `json:"state"`
+	TaskId           string        `json:"taskId"`
+	StartTime        time.Time     `json:"startTime"`
+	Phase            PomodoroPhase `json:"phase"`
+	PhaseCount       int           `json:"phaseCount"`
+	RemainingTimeSec int           `json:"remainingTimeSec"`
+	ElapsedTimeSec   int           `json:"elapsedTimeSec"`
+	PhaseDurationSec int           `json:"phaseDurationSec"`
+	BreakFrequency   int           `json:"breakFrequency"`
+	EndTime          time.Time     `json:"endTime"`
+	AutoAdvance      bool          `json:"autoAdvance"`
+}
+
+// GetId returns PomodoroDetails.Id, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetId() string { return v.Id }
+
+// GetState returns PomodoroDetails.State, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetState() PomodoroState { return v.State }
+
+// GetTaskId returns PomodoroDetails.TaskId, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetTaskId() string { return v.TaskId }
+
+// GetStartTime returns PomodoroDetails.StartTime, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetStartTime() time.Time { return v.StartTime }
+
+// GetPhase returns PomodoroDetails.Phase, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetPhase() PomodoroPhase { return v.Phase }
+
+// GetPhaseCount returns PomodoroDetails.PhaseCount, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetPhaseCount() int { return v.PhaseCount }
+
+// GetRemainingTimeSec returns PomodoroDetails.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetRemainingTimeSec() int { return v.RemainingTimeSec }
+
+// GetElapsedTimeSec returns PomodoroDetails.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetElapsedTimeSec() int { return v.ElapsedTimeSec }
+
+// GetPhaseDurationSec returns PomodoroDetails.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetPhaseDurationSec() int { return v.PhaseDurationSec }
+
+// GetBreakFrequency returns PomodoroDetails.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetBreakFrequency() int { return v.BreakFrequency }
+
+// GetEndTime returns PomodoroDetails.EndTime, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetEndTime() time.Time { return v.EndTime }
+
+// GetAutoAdvance returns PomodoroDetails.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *PomodoroDetails) GetAutoAdvance() bool { return v.AutoAdvance }
+
+type PomodoroFilter struct {
+	TaskId        *string         `json:"taskId,omitempty"`
+	Phase         []PomodoroPhase `json:"phase,omitempty"`
+	StartedAfter  *time.Time      `json:"startedAfter,omitempty"`
+	StartedBefore *time.Time      `json:"startedBefore,omitempty"`
+}
+
+// GetTaskId returns PomodoroFilter.TaskId, and is useful for accessing the field via an interface.
+func (v *PomodoroFilter) GetTaskId() *string { return v.TaskId }
+
+// GetPhase returns PomodoroFilter.Phase, and is useful for accessing the field via an interface.
+func (v *PomodoroFilter) GetPhase() []PomodoroPhase { return v.Phase }
+
+// GetStartedAfter returns PomodoroFilter.StartedAfter, and is useful for accessing the field via an interface.
+func (v *PomodoroFilter) GetStartedAfter() *time.Time { return v.StartedAfter }
+
+// GetStartedBefore returns PomodoroFilter.StartedBefore, and is useful for accessing the field via an interface.
+func (v *PomodoroFilter) GetStartedBefore() *time.Time { return v.StartedBefore }
+
+type PomodoroPhase string
+
+const (
+	PomodoroPhaseWork       PomodoroPhase = "WORK"
+	PomodoroPhaseShortBreak PomodoroPhase = "SHORT_BREAK"
+	PomodoroPhaseLongBreak  PomodoroPhase = "LONG_BREAK"
+)
+
+var AllPomodoroPhase = []PomodoroPhase{
+	PomodoroPhaseWork,
+	PomodoroPhaseShortBreak,
+	PomodoroPhaseLongBreak,
+}
+
+type PomodoroState string
+
+const (
+	PomodoroStateActive   PomodoroState = "ACTIVE"
+	PomodoroStatePaused   PomodoroState = "PAUSED"
+	PomodoroStateFinished PomodoroState = "FINISHED"
+)
+
+var AllPomodoroState = []PomodoroState{
+	PomodoroStateActive,
+	PomodoroStatePaused,
+	PomodoroStateFinished,
+}
+
+// ResetPomodoroResetPomodoro includes the requested fields of the GraphQL type Pomodoro.
+type ResetPomodoroResetPomodoro struct {
+	PomodoroDetails `json:"-"`
+}
+
+// GetId returns ResetPomodoroResetPomodoro.Id, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetId() string { return v.PomodoroDetails.Id }
+
+// GetState returns ResetPomodoroResetPomodoro.State, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+
+// GetTaskId returns ResetPomodoroResetPomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+
+// GetStartTime returns ResetPomodoroResetPomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+
+// GetPhase returns ResetPomodoroResetPomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+
+// GetPhaseCount returns ResetPomodoroResetPomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+
+// GetRemainingTimeSec returns ResetPomodoroResetPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetRemainingTimeSec() int {
+	return v.PomodoroDetails.RemainingTimeSec
+}
+
+// GetElapsedTimeSec returns ResetPomodoroResetPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+
+// GetPhaseDurationSec returns ResetPomodoroResetPomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns ResetPomodoroResetPomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetBreakFrequency() int { return v.PomodoroDetails.BreakFrequency }
+
+// GetEndTime returns ResetPomodoroResetPomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns ResetPomodoroResetPomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResetPomodoro) GetAutoAdvance() bool { return v.PomodoroDetails.AutoAdvance }
+
+func (v *ResetPomodoroResetPomodoro) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ResetPomodoroResetPomodoro
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ResetPomodoroResetPomodoro = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PomodoroDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalResetPomodoroResetPomodoro struct {
+	Id string `json:"id"`
+
+	State PomodoroState `json:"state"`
+
+	TaskId string `json:"taskId"`
+
+	StartTime time.Time `json:"startTime"`
+
+	Phase PomodoroPhase `json:"phase"`
+
+	PhaseCount int `json:"phaseCount"`
+
+	RemainingTimeSec int `json:"remainingTimeSec"`
+
+	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
+}
+
+func (v *ResetPomodoroResetPomodoro) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ResetPomodoroResetPomodoro) __premarshalJSON() (*__premarshalResetPomodoroResetPomodoro, error) {
+	var retval __premarshalResetPomodoroResetPomodoro
+
+	retval.Id = v.PomodoroDetails.Id
+	retval.State = v.PomodoroDetails.State
+	retval.TaskId = v.PomodoroDetails.TaskId
+	retval.StartTime = v.PomodoroDetails.StartTime
+	retval.Phase = v.PomodoroDetails.Phase
+	retval.PhaseCount = v.PomodoroDetails.PhaseCount
+	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
+	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
+	return &retval, nil
+}
+
+// ResetPomodoroResponse is returned by ResetPomodoro on success.
+type ResetPomodoroResponse struct {
+	ResetPomodoro ResetPomodoroResetPomodoro `json:"resetPomodoro"`
+}
+
+// GetResetPomodoro returns ResetPomodoroResponse.ResetPomodoro, and is useful for accessing the field via an interface.
+func (v *ResetPomodoroResponse) GetResetPomodoro() ResetPomodoroResetPomodoro { return v.ResetPomodoro }
+
+// ResumePomodoroResponse is returned by ResumePomodoro on success.
+type ResumePomodoroResponse struct {
+	ResumePomodoro ResumePomodoroResumePomodoro `json:"resumePomodoro"`
+}
+
+// GetResumePomodoro returns ResumePomodoroResponse.ResumePomodoro, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResponse) GetResumePomodoro() ResumePomodoroResumePomodoro {
+	return v.ResumePomodoro
+}
+
+// ResumePomodoroResumePomodoro includes the requested fields of the GraphQL type Pomodoro.
+type ResumePomodoroResumePomodoro struct {
+	PomodoroDetails `json:"-"`
+}
+
+// GetId returns ResumePomodoroResumePomodoro.Id, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetId() string { return v.PomodoroDetails.Id }
+
+// GetState returns ResumePomodoroResumePomodoro.State, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+
+// GetTaskId returns ResumePomodoroResumePomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+
+// GetStartTime returns ResumePomodoroResumePomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+
+// GetPhase returns ResumePomodoroResumePomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+
+// GetPhaseCount returns ResumePomodoroResumePomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+
+// GetRemainingTimeSec returns ResumePomodoroResumePomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetRemainingTimeSec() int {
+	return v.PomodoroDetails.RemainingTimeSec
+}
+
+// GetElapsedTimeSec returns ResumePomodoroResumePomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetElapsedTimeSec() int {
+	return v.PomodoroDetails.ElapsedTimeSec
+}
+
+// GetPhaseDurationSec returns ResumePomodoroResumePomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns ResumePomodoroResumePomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetBreakFrequency() int {
+	return v.PomodoroDetails.BreakFrequency
+}
+
+// GetEndTime returns ResumePomodoroResumePomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns ResumePomodoroResumePomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *ResumePomodoroResumePomodoro) GetAutoAdvance() bool { return v.PomodoroDetails.AutoAdvance }
+
+func (v *ResumePomodoroResumePomodoro) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ResumePomodoroResumePomodoro
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ResumePomodoroResumePomodoro = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PomodoroDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalResumePomodoroResumePomodoro struct {
+	Id string `json:"id"`
+
+	State PomodoroState `json:"state"`
+
+	TaskId string `json:"taskId"`
+
+	StartTime time.Time `json:"startTime"`
+
+	Phase PomodoroPhase `json:"phase"`
+
+	PhaseCount int `json:"phaseCount"`
+
+	RemainingTimeSec int `json:"remainingTimeSec"`
+
+	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
+}
+
+func (v *ResumePomodoroResumePomodoro) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ResumePomodoroResumePomodoro) __premarshalJSON() (*__premarshalResumePomodoroResumePomodoro, error) {
+	var retval __premarshalResumePomodoroResumePomodoro
+
+	retval.Id = v.PomodoroDetails.Id
+	retval.State = v.PomodoroDetails.State
+	retval.TaskId = v.PomodoroDetails.TaskId
 	retval.StartTime = v.PomodoroDetails.StartTime
 	retval.Phase = v.PomodoroDetails.Phase
 	retval.PhaseCount = v.PomodoroDetails.PhaseCount
 	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
 	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
 	return &retval, nil
 }
 
-// ResetPomodoroResponse is returned by ResetPomodoro on success.
-type ResetPomodoroResponse struct {
-	ResetPomodoro ResetPomodoroResetPomodoro `json:"resetPomodoro"`
+type StartPomodoroInput struct {
+	WorkDurationSec      int    `json:"workDurationSec"`
+	BreakDurationSec     int    `json:"breakDurationSec"`
+	LongBreakDurationSec int    `json:"longBreakDurationSec"`
+	BreakFrequency       int    `json:"breakFrequency"`
+	TaskId               string `json:"taskId"`
+	AutoAdvance          bool   `json:"autoAdvance"`
+	SkipBreak            bool   `json:"skipBreak"`
 }
 
-// GetResetPomodoro returns ResetPomodoroResponse.ResetPomodoro, and is useful for accessing the field via an interface.
-func (v *ResetPomodoroResponse) GetResetPomodoro() ResetPomodoroResetPomodoro { return v.ResetPomodoro }
+// GetWorkDurationSec returns StartPomodoroInput.WorkDurationSec, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetWorkDurationSec() int { return v.WorkDurationSec }
 
-// ResumePomodoroResponse is returned by ResumePomodoro on success.
-type ResumePomodoroResponse struct {
-	ResumePomodoro ResumePomodoroResumePomodoro `json:"resumePomodoro"`
+// GetBreakDurationSec returns StartPomodoroInput.BreakDurationSec, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetBreakDurationSec() int { return v.BreakDurationSec }
+
+// GetLongBreakDurationSec returns StartPomodoroInput.LongBreakDurationSec, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetLongBreakDurationSec() int { return v.LongBreakDurationSec }
+
+// GetBreakFrequency returns StartPomodoroInput.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetBreakFrequency() int { return v.BreakFrequency }
+
+// GetTaskId returns StartPomodoroInput.TaskId, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetTaskId() string { return v.TaskId }
+
+// GetAutoAdvance returns StartPomodoroInput.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetAutoAdvance() bool { return v.AutoAdvance }
+
+// GetSkipBreak returns StartPomodoroInput.SkipBreak, and is useful for accessing the field via an interface.
+func (v *StartPomodoroInput) GetSkipBreak() bool { return v.SkipBreak }
+
+// StartPomodoroResponse is returned by StartPomodoro on success.
+type StartPomodoroResponse struct {
+	StartPomodoro StartPomodoroStartPomodoro `json:"startPomodoro"`
+}
+
+// GetStartPomodoro returns StartPomodoroResponse.StartPomodoro, and is useful for accessing the field via an interface.
+func (v *StartPomodoroResponse) GetStartPomodoro() StartPomodoroStartPomodoro { return v.StartPomodoro }
+
+// StartPomodoroStartPomodoro includes the requested fields of the GraphQL type Pomodoro.
+type StartPomodoroStartPomodoro struct {
+	PomodoroDetails `json:"-"`
+}
+
+// GetId returns StartPomodoroStartPomodoro.Id, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetId() string { return v.PomodoroDetails.Id }
+
+// GetState returns StartPomodoroStartPomodoro.State, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+
+// GetTaskId returns StartPomodoroStartPomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+
+// GetStartTime returns StartPomodoroStartPomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+
+// GetPhase returns StartPomodoroStartPomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+
+// GetPhaseCount returns StartPomodoroStartPomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+
+// GetRemainingTimeSec returns StartPomodoroStartPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetRemainingTimeSec() int {
+	return v.PomodoroDetails.RemainingTimeSec
+}
+
+// GetElapsedTimeSec returns StartPomodoroStartPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+
+// GetPhaseDurationSec returns StartPomodoroStartPomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
+}
+
+// GetBreakFrequency returns StartPomodoroStartPomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetBreakFrequency() int { return v.PomodoroDetails.BreakFrequency }
+
+// GetEndTime returns StartPomodoroStartPomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns StartPomodoroStartPomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *StartPomodoroStartPomodoro) GetAutoAdvance() bool { return v.PomodoroDetails.AutoAdvance }
+
+func (v *StartPomodoroStartPomodoro) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*StartPomodoroStartPomodoro
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.StartPomodoroStartPomodoro = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PomodoroDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalStartPomodoroStartPomodoro struct {
+	Id string `json:"id"`
+
+	State PomodoroState `json:"state"`
+
+	TaskId string `json:"taskId"`
+
+	StartTime time.Time `json:"startTime"`
+
+	Phase PomodoroPhase `json:"phase"`
+
+	PhaseCount int `json:"phaseCount"`
+
+	RemainingTimeSec int `json:"remainingTimeSec"`
+
+	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
+}
+
+func (v *StartPomodoroStartPomodoro) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *StartPomodoroStartPomodoro) __premarshalJSON() (*__premarshalStartPomodoroStartPomodoro, error) {
+	var retval __premarshalStartPomodoroStartPomodoro
+
+	retval.Id = v.PomodoroDetails.Id
+	retval.State = v.PomodoroDetails.State
+	retval.TaskId = v.PomodoroDetails.TaskId
+	retval.StartTime = v.PomodoroDetails.StartTime
+	retval.Phase = v.PomodoroDetails.Phase
+	retval.PhaseCount = v.PomodoroDetails.PhaseCount
+	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
+	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
+	return &retval, nil
+}
+
+// StatsDetails includes the GraphQL fields of Stats requested by the fragment StatsDetails.
+type StatsDetails struct {
+	Start          time.Time                      `json:"start"`
+	End            time.Time                      `json:"end"`
+	FocusTimeSec   int                            `json:"focusTimeSec"`
+	Sessions       int                            `json:"sessions"`
+	Interruptions  int                            `json:"interruptions"`
+	BreakAdherence *float64                       `json:"breakAdherence"`
+	Groups         []StatsDetailsGroupsStatsGroup `json:"groups"`
+}
+
+// GetStart returns StatsDetails.Start, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetStart() time.Time { return v.Start }
+
+// GetEnd returns StatsDetails.End, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetEnd() time.Time { return v.End }
+
+// GetFocusTimeSec returns StatsDetails.FocusTimeSec, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetFocusTimeSec() int { return v.FocusTimeSec }
+
+// GetSessions returns StatsDetails.Sessions, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetSessions() int { return v.Sessions }
+
+// GetInterruptions returns StatsDetails.Interruptions, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetInterruptions() int { return v.Interruptions }
+
+// GetBreakAdherence returns StatsDetails.BreakAdherence, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetBreakAdherence() *float64 { return v.BreakAdherence }
+
+// GetGroups returns StatsDetails.Groups, and is useful for accessing the field via an interface.
+func (v *StatsDetails) GetGroups() []StatsDetailsGroupsStatsGroup { return v.Groups }
+
+// StatsDetailsGroupsStatsGroup includes the requested fields of the GraphQL type StatsGroup.
+type StatsDetailsGroupsStatsGroup struct {
+	StatsGroupDetails `json:"-"`
+}
+
+// GetKey returns StatsDetailsGroupsStatsGroup.Key, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetKey() string { return v.StatsGroupDetails.Key }
+
+// GetStart returns StatsDetailsGroupsStatsGroup.Start, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetStart() time.Time { return v.StatsGroupDetails.Start }
+
+// GetEnd returns StatsDetailsGroupsStatsGroup.End, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetEnd() time.Time { return v.StatsGroupDetails.End }
+
+// GetFocusTimeSec returns StatsDetailsGroupsStatsGroup.FocusTimeSec, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetFocusTimeSec() int { return v.StatsGroupDetails.FocusTimeSec }
+
+// GetSessions returns StatsDetailsGroupsStatsGroup.Sessions, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetSessions() int { return v.StatsGroupDetails.Sessions }
+
+// GetInterruptions returns StatsDetailsGroupsStatsGroup.Interruptions, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetInterruptions() int {
+	return v.StatsGroupDetails.Interruptions
+}
+
+// GetBreakAdherence returns StatsDetailsGroupsStatsGroup.BreakAdherence, and is useful for accessing the field via an interface.
+func (v *StatsDetailsGroupsStatsGroup) GetBreakAdherence() *float64 {
+	return v.StatsGroupDetails.BreakAdherence
+}
+
+func (v *StatsDetailsGroupsStatsGroup) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*StatsDetailsGroupsStatsGroup
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.StatsDetailsGroupsStatsGroup = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.StatsGroupDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalStatsDetailsGroupsStatsGroup struct {
+	Key string `json:"key"`
+
+	Start time.Time `json:"start"`
+
+	End time.Time `json:"end"`
+
+	FocusTimeSec int `json:"focusTimeSec"`
+
+	Sessions int `json:"sessions"`
+
+	Interruptions int `json:"interruptions"`
+
+	BreakAdherence *float64 `json:"breakAdherence"`
+}
+
+func (v *StatsDetailsGroupsStatsGroup) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *StatsDetailsGroupsStatsGroup) __premarshalJSON() (*__premarshalStatsDetailsGroupsStatsGroup, error) {
+	var retval __premarshalStatsDetailsGroupsStatsGroup
+
+	retval.Key = v.StatsGroupDetails.Key
+	retval.Start = v.StatsGroupDetails.Start
+	retval.End = v.StatsGroupDetails.End
+	retval.FocusTimeSec = v.StatsGroupDetails.FocusTimeSec
+	retval.Sessions = v.StatsGroupDetails.Sessions
+	retval.Interruptions = v.StatsGroupDetails.Interruptions
+	retval.BreakAdherence = v.StatsGroupDetails.BreakAdherence
+	return &retval, nil
+}
+
+type StatsGroupBy string
+
+const (
+	StatsGroupByDay     StatsGroupBy = "DAY"
+	StatsGroupByWeek    StatsGroupBy = "WEEK"
+	StatsGroupByTask    StatsGroupBy = "TASK"
+	StatsGroupByProject StatsGroupBy = "PROJECT"
+	StatsGroupByTag     StatsGroupBy = "TAG"
+)
+
+var AllStatsGroupBy = []StatsGroupBy{
+	StatsGroupByDay,
+	StatsGroupByWeek,
+	StatsGroupByTask,
+	StatsGroupByProject,
+	StatsGroupByTag,
+}
+
+// StatsGroupDetails includes the GraphQL fields of StatsGroup requested by the fragment StatsGroupDetails.
+type StatsGroupDetails struct {
+	Key            string    `json:"key"`
+	Start          time.Time `json:"start"`
+	End            time.Time `json:"end"`
+	FocusTimeSec   int       `json:"focusTimeSec"`
+	Sessions       int       `json:"sessions"`
+	Interruptions  int       `json:"interruptions"`
+	BreakAdherence *float64  `json:"breakAdherence"`
+}
+
+// GetKey returns StatsGroupDetails.Key, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetKey() string { return v.Key }
+
+// GetStart returns StatsGroupDetails.Start, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetStart() time.Time { return v.Start }
+
+// GetEnd returns StatsGroupDetails.End, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetEnd() time.Time { return v.End }
+
+// GetFocusTimeSec returns StatsGroupDetails.FocusTimeSec, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetFocusTimeSec() int { return v.FocusTimeSec }
+
+// GetSessions returns StatsGroupDetails.Sessions, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetSessions() int { return v.Sessions }
+
+// GetInterruptions returns StatsGroupDetails.Interruptions, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetInterruptions() int { return v.Interruptions }
+
+// GetBreakAdherence returns StatsGroupDetails.BreakAdherence, and is useful for accessing the field via an interface.
+func (v *StatsGroupDetails) GetBreakAdherence() *float64 { return v.BreakAdherence }
+
+type StatsRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
 }
 
-// GetResumePomodoro returns ResumePomodoroResponse.ResumePomodoro, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResponse) GetResumePomodoro() ResumePomodoroResumePomodoro {
-	return v.ResumePomodoro
+// GetStart returns StatsRange.Start, and is useful for accessing the field via an interface.
+func (v *StatsRange) GetStart() time.Time { return v.Start }
+
+// GetEnd returns StatsRange.End, and is useful for accessing the field via an interface.
+func (v *StatsRange) GetEnd() time.Time { return v.End }
+
+// StopPomodoroResponse is returned by StopPomodoro on success.
+type StopPomodoroResponse struct {
+	StopPomodoro StopPomodoroStopPomodoro `json:"stopPomodoro"`
 }
 
-// ResumePomodoroResumePomodoro includes the requested fields of the GraphQL type Pomodoro.
-type ResumePomodoroResumePomodoro struct {
+// GetStopPomodoro returns StopPomodoroResponse.StopPomodoro, and is useful for accessing the field via an interface.
+func (v *StopPomodoroResponse) GetStopPomodoro() StopPomodoroStopPomodoro { return v.StopPomodoro }
+
+// StopPomodoroStopPomodoro includes the requested fields of the GraphQL type Pomodoro.
+type StopPomodoroStopPomodoro struct {
 	PomodoroDetails `json:"-"`
 }
 
-// GetId returns ResumePomodoroResumePomodoro.Id, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetId() string { return v.PomodoroDetails.Id }
+// GetId returns StopPomodoroStopPomodoro.Id, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetId() string { return v.PomodoroDetails.Id }
 
-// GetState returns ResumePomodoroResumePomodoro.State, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+// GetState returns StopPomodoroStopPomodoro.State, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
 
-// GetTaskId returns ResumePomodoroResumePomodoro.TaskId, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+// GetTaskId returns StopPomodoroStopPomodoro.TaskId, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
 
-// GetStartTime returns ResumePomodoroResumePomodoro.StartTime, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+// GetStartTime returns StopPomodoroStopPomodoro.StartTime, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
 
-// GetPhase returns ResumePomodoroResumePomodoro.Phase, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+// GetPhase returns StopPomodoroStopPomodoro.Phase, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
 
-// GetPhaseCount returns ResumePomodoroResumePomodoro.PhaseCount, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+// GetPhaseCount returns StopPomodoroStopPomodoro.PhaseCount, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
 
-// GetRemainingTimeSec returns ResumePomodoroResumePomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetRemainingTimeSec() int {
+// GetRemainingTimeSec returns StopPomodoroStopPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetRemainingTimeSec() int {
 	return v.PomodoroDetails.RemainingTimeSec
 }
 
-// GetElapsedTimeSec returns ResumePomodoroResumePomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
-func (v *ResumePomodoroResumePomodoro) GetElapsedTimeSec() int {
-	return v.PomodoroDetails.ElapsedTimeSec
+// GetElapsedTimeSec returns StopPomodoroStopPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+
+// GetPhaseDurationSec returns StopPomodoroStopPomodoro.PhaseDurationSec, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetPhaseDurationSec() int {
+	return v.PomodoroDetails.PhaseDurationSec
 }
 
-func (v *ResumePomodoroResumePomodoro) UnmarshalJSON(b []byte) error {
+// GetBreakFrequency returns StopPomodoroStopPomodoro.BreakFrequency, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetBreakFrequency() int { return v.PomodoroDetails.BreakFrequency }
+
+// GetEndTime returns StopPomodoroStopPomodoro.EndTime, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetEndTime() time.Time { return v.PomodoroDetails.EndTime }
+
+// GetAutoAdvance returns StopPomodoroStopPomodoro.AutoAdvance, and is useful for accessing the field via an interface.
+func (v *StopPomodoroStopPomodoro) GetAutoAdvance() bool { return v.PomodoroDetails.AutoAdvance }
+
+func (v *StopPomodoroStopPomodoro) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ResumePomodoroResumePomodoro
+		*StopPomodoroStopPomodoro
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ResumePomodoroResumePomodoro = v
+	firstPass.StopPomodoroStopPomodoro = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1234,7 +2956,7 @@ func (v *ResumePomodoroResumePomodoro) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-type __premarshalResumePomodoroResumePomodoro struct {
+type __premarshalStopPomodoroStopPomodoro struct {
 	Id string `json:"id"`
 
 	State PomodoroState `json:"state"`
@@ -1250,9 +2972,17 @@ type __premarshalResumePomodoroResumePomodoro struct {
 	RemainingTimeSec int `json:"remainingTimeSec"`
 
 	ElapsedTimeSec int `json:"elapsedTimeSec"`
+
+	PhaseDurationSec int `json:"phaseDurationSec"`
+
+	BreakFrequency int `json:"breakFrequency"`
+
+	EndTime time.Time `json:"endTime"`
+
+	AutoAdvance bool `json:"autoAdvance"`
 }
 
-func (v *ResumePomodoroResumePomodoro) MarshalJSON() ([]byte, error) {
+func (v *StopPomodoroStopPomodoro) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1260,8 +2990,8 @@ func (v *ResumePomodoroResumePomodoro) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *ResumePomodoroResumePomodoro) __premarshalJSON() (*__premarshalResumePomodoroResumePomodoro, error) {
-	var retval __premarshalResumePomodoroResumePomodoro
+func (v *StopPomodoroStopPomodoro) __premarshalJSON() (*__premarshalStopPomodoroStopPomodoro, error) {
+	var retval __premarshalStopPomodoroStopPomodoro
 
 	retval.Id = v.PomodoroDetails.Id
 	retval.State = v.PomodoroDetails.State
@@ -1271,78 +3001,177 @@ func (v *ResumePomodoroResumePomodoro) __premarshalJSON() (*__premarshalResumePo
 	retval.PhaseCount = v.PomodoroDetails.PhaseCount
 	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
 	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.PhaseDurationSec = v.PomodoroDetails.PhaseDurationSec
+	retval.BreakFrequency = v.PomodoroDetails.BreakFrequency
+	retval.EndTime = v.PomodoroDetails.EndTime
+	retval.AutoAdvance = v.PomodoroDetails.AutoAdvance
 	return &retval, nil
 }
 
-type StartPomodoroInput struct {
-	WorkDurationSec      int    `json:"workDurationSec"`
-	BreakDurationSec     int    `json:"breakDurationSec"`
-	LongBreakDurationSec int    `json:"longBreakDurationSec"`
-	TaskId               string `json:"taskId"`
+// TaskDetails includes the GraphQL fields of Task requested by the fragment TaskDetails.
+type TaskDetails struct {
+	Id                 string     `json:"id"`
+	Title              string     `json:"title"`
+	Project            string     `json:"project"`
+	Tags               []string   `json:"tags"`
+	EstimatedPomodoros int        `json:"estimatedPomodoros"`
+	Notes              string     `json:"notes"`
+	Status             TaskStatus `json:"status"`
+	CreatedAt          time.Time  `json:"createdAt"`
 }
 
-// GetWorkDurationSec returns StartPomodoroInput.WorkDurationSec, and is useful for accessing the field via an interface.
-func (v *StartPomodoroInput) GetWorkDurationSec() int { return v.WorkDurationSec }
+// GetId returns TaskDetails.Id, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetId() string { return v.Id }
 
-// GetBreakDurationSec returns StartPomodoroInput.BreakDurationSec, and is useful for accessing the field via an interface.
-func (v *StartPomodoroInput) GetBreakDurationSec() int { return v.BreakDurationSec }
+// GetTitle returns TaskDetails.Title, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetTitle() string { return v.Title }
 
-// GetLongBreakDurationSec returns StartPomodoroInput.LongBreakDurationSec, and is useful for accessing the field via an interface.
-func (v *StartPomodoroInput) GetLongBreakDurationSec() int { return v.LongBreakDurationSec }
+// GetProject returns TaskDetails.Project, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetProject() string { return v.Project }
 
-// GetTaskId returns StartPomodoroInput.TaskId, and is useful for accessing the field via an interface.
-func (v *StartPomodoroInput) GetTaskId() string { return v.TaskId }
+// GetTags returns TaskDetails.Tags, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetTags() []string { return v.Tags }
 
-// StartPomodoroResponse is returned by StartPomodoro on success.
-type StartPomodoroResponse struct {
-	StartPomodoro StartPomodoroStartPomodoro `json:"startPomodoro"`
+// GetEstimatedPomodoros returns TaskDetails.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetEstimatedPomodoros() int { return v.EstimatedPomodoros }
+
+// GetNotes returns TaskDetails.Notes, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetNotes() string { return v.Notes }
+
+// GetStatus returns TaskDetails.Status, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetStatus() TaskStatus { return v.Status }
+
+// GetCreatedAt returns TaskDetails.CreatedAt, and is useful for accessing the field via an interface.
+func (v *TaskDetails) GetCreatedAt() time.Time { return v.CreatedAt }
+
+type TaskFilter struct {
+	TitleContains *string      `json:"titleContains,omitempty"`
+	Tag           *string      `json:"tag,omitempty"`
+	Status        []TaskStatus `json:"status,omitempty"`
+	CreatedAfter  *time.Time   `json:"createdAfter,omitempty"`
+	CreatedBefore *time.Time   `json:"createdBefore,omitempty"`
 }
 
-// GetStartPomodoro returns StartPomodoroResponse.StartPomodoro, and is useful for accessing the field via an interface.
-func (v *StartPomodoroResponse) GetStartPomodoro() StartPomodoroStartPomodoro { return v.StartPomodoro }
+// GetTitleContains returns TaskFilter.TitleContains, and is useful for accessing the field via an interface.
+func (v *TaskFilter) GetTitleContains() *string { return v.TitleContains }
 
-// StartPomodoroStartPomodoro includes the requested fields of the GraphQL type Pomodoro.
-type StartPomodoroStartPomodoro struct {
-	PomodoroDetails `json:"-"`
+// GetTag returns TaskFilter.Tag, and is useful for accessing the field via an interface.
+func (v *TaskFilter) GetTag() *string { return v.Tag }
+
+// GetStatus returns TaskFilter.Status, and is useful for accessing the field via an interface.
+func (v *TaskFilter) GetStatus() []TaskStatus { return v.Status }
+
+// GetCreatedAfter returns TaskFilter.CreatedAfter, and is useful for accessing the field via an interface.
+func (v *TaskFilter) GetCreatedAfter() *time.Time { return v.CreatedAfter }
+
+// GetCreatedBefore returns TaskFilter.CreatedBefore, and is useful for accessing the field via an interface.
+func (v *TaskFilter) GetCreatedBefore() *time.Time { return v.CreatedBefore }
+
+type TaskStatus string
+
+const (
+	TaskStatusTodo       TaskStatus = "TODO"
+	TaskStatusInProgress TaskStatus = "IN_PROGRESS"
+	TaskStatusDone       TaskStatus = "DONE"
+	TaskStatusArchived   TaskStatus = "ARCHIVED"
+)
+
+var AllTaskStatus = []TaskStatus{
+	TaskStatusTodo,
+	TaskStatusInProgress,
+	TaskStatusDone,
+	TaskStatusArchived,
 }
 
-// GetId returns StartPomodoroStartPomodoro.Id, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetId() string { return v.PomodoroDetails.Id }
+type UpdateTaskInput struct {
+	Id                 string   `json:"id"`
+	Title              *string  `json:"title,omitempty"`
+	Project            *string  `json:"project,omitempty"`
+	Tags               []string `json:"tags"`
+	EstimatedPomodoros *int     `json:"estimatedPomodoros,omitempty"`
+	Notes              *string  `json:"notes,omitempty"`
+}
 
-// GetState returns StartPomodoroStartPomodoro.State, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+// GetId returns UpdateTaskInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateTaskInput) GetId() string { return v.Id }
 
-// GetTaskId returns StartPomodoroStartPomodoro.TaskId, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+// GetTitle returns UpdateTaskInput.Title, and is useful for accessing the field via an interface.
+func (v *UpdateTaskInput) GetTitle() *string { return v.Title }
 
-// GetStartTime returns StartPomodoroStartPomodoro.StartTime, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+// GetProject returns UpdateTaskInput.Project, and is useful for accessing the field via an interface.
+func (v *UpdateTaskInput) GetProject() *string { return v.Project }
 
-// GetPhase returns StartPomodoroStartPomodoro.Phase, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+// GetTags returns UpdateTaskInput.Tags, and is useful for accessing the field via an interface.
+func (v *UpdateTaskInput) GetTags() []string { return v.Tags }
 
-// GetPhaseCount returns StartPomodoroStartPomodoro.PhaseCount, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+// GetEstimatedPomodoros returns UpdateTaskInput.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *UpdateTaskInput) GetEstimatedPomodoros() *int { return v.EstimatedPomodoros }
 
-// GetRemainingTimeSec returns StartPomodoroStartPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetRemainingTimeSec() int {
-	return v.PomodoroDetails.RemainingTimeSec
+// GetNotes returns UpdateTaskInput.Notes, and is useful for accessing the field via an interface.
+func (v *UpdateTaskInput) GetNotes() *string { return v.Notes }
+
+// UpdateTaskResponse is returned by UpdateTask on success.
+type UpdateTaskResponse struct {
+	UpdateTask UpdateTaskUpdateTask `json:"updateTask"`
 }
 
-// GetElapsedTimeSec returns StartPomodoroStartPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
-func (v *StartPomodoroStartPomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+// GetUpdateTask returns UpdateTaskResponse.UpdateTask, and is useful for accessing the field via an interface.
+func (v *UpdateTaskResponse) GetUpdateTask() UpdateTaskUpdateTask { return v.UpdateTask }
 
-func (v *StartPomodoroStartPomodoro) UnmarshalJSON(b []byte) error {
+// UpdateTaskStatusResponse is returned by UpdateTaskStatus on success.
+type UpdateTaskStatusResponse struct {
+	UpdateTaskStatus UpdateTaskStatusUpdateTaskStatusTask `json:"updateTaskStatus"`
+}
+
+// GetUpdateTaskStatus returns UpdateTaskStatusResponse.UpdateTaskStatus, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusResponse) GetUpdateTaskStatus() UpdateTaskStatusUpdateTaskStatusTask {
+	return v.UpdateTaskStatus
+}
+
+// UpdateTaskStatusUpdateTaskStatusTask includes the requested fields of the GraphQL type Task.
+type UpdateTaskStatusUpdateTaskStatusTask struct {
+	TaskDetails `json:"-"`
+}
+
+// GetId returns UpdateTaskStatusUpdateTaskStatusTask.Id, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetId() string { return v.TaskDetails.Id }
+
+// GetTitle returns UpdateTaskStatusUpdateTaskStatusTask.Title, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetTitle() string { return v.TaskDetails.Title }
+
+// GetProject returns UpdateTaskStatusUpdateTaskStatusTask.Project, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetProject() string { return v.TaskDetails.Project }
+
+// GetTags returns UpdateTaskStatusUpdateTaskStatusTask.Tags, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetTags() []string { return v.TaskDetails.Tags }
+
+// GetEstimatedPomodoros returns UpdateTaskStatusUpdateTaskStatusTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetEstimatedPomodoros() int {
+	return v.TaskDetails.EstimatedPomodoros
+}
+
+// GetNotes returns UpdateTaskStatusUpdateTaskStatusTask.Notes, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetNotes() string { return v.TaskDetails.Notes }
+
+// GetStatus returns UpdateTaskStatusUpdateTaskStatusTask.Status, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
+
+// GetCreatedAt returns UpdateTaskStatusUpdateTaskStatusTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *UpdateTaskStatusUpdateTaskStatusTask) GetCreatedAt() time.Time {
+	return v.TaskDetails.CreatedAt
+}
+
+func (v *UpdateTaskStatusUpdateTaskStatusTask) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*StartPomodoroStartPomodoro
+		*UpdateTaskStatusUpdateTaskStatusTask
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.StartPomodoroStartPomodoro = v
+	firstPass.UpdateTaskStatusUpdateTaskStatusTask = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1350,32 +3179,32 @@ func (v *StartPomodoroStartPomodoro) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.PomodoroDetails)
+		b, &v.TaskDetails)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalStartPomodoroStartPomodoro struct {
+type __premarshalUpdateTaskStatusUpdateTaskStatusTask struct {
 	Id string `json:"id"`
 
-	State PomodoroState `json:"state"`
+	Title string `json:"title"`
 
-	TaskId string `json:"taskId"`
+	Project string `json:"project"`
 
-	StartTime time.Time `json:"startTime"`
+	Tags []string `json:"tags"`
 
-	Phase PomodoroPhase `json:"phase"`
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
 
-	PhaseCount int `json:"phaseCount"`
+	Notes string `json:"notes"`
 
-	RemainingTimeSec int `json:"remainingTimeSec"`
+	Status TaskStatus `json:"status"`
 
-	ElapsedTimeSec int `json:"elapsedTimeSec"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
-func (v *StartPomodoroStartPomodoro) MarshalJSON() ([]byte, error) {
+func (v *UpdateTaskStatusUpdateTaskStatusTask) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1383,70 +3212,60 @@ func (v *StartPomodoroStartPomodoro) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *StartPomodoroStartPomodoro) __premarshalJSON() (*__premarshalStartPomodoroStartPomodoro, error) {
-	var retval __premarshalStartPomodoroStartPomodoro
+func (v *UpdateTaskStatusUpdateTaskStatusTask) __premarshalJSON() (*__premarshalUpdateTaskStatusUpdateTaskStatusTask, error) {
+	var retval __premarshalUpdateTaskStatusUpdateTaskStatusTask
 
-	retval.Id = v.PomodoroDetails.Id
-	retval.State = v.PomodoroDetails.State
-	retval.TaskId = v.PomodoroDetails.TaskId
-	retval.StartTime = v.PomodoroDetails.StartTime
-	retval.Phase = v.PomodoroDetails.Phase
-	retval.PhaseCount = v.PomodoroDetails.PhaseCount
-	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
-	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
 	return &retval, nil
 }
 
-// StopPomodoroResponse is returned by StopPomodoro on success.
-type StopPomodoroResponse struct {
-	StopPomodoro StopPomodoroStopPomodoro `json:"stopPomodoro"`
-}
-
-// GetStopPomodoro returns StopPomodoroResponse.StopPomodoro, and is useful for accessing the field via an interface.
-func (v *StopPomodoroResponse) GetStopPomodoro() StopPomodoroStopPomodoro { return v.StopPomodoro }
-
-// StopPomodoroStopPomodoro includes the requested fields of the GraphQL type Pomodoro.
-type StopPomodoroStopPomodoro struct {
-	PomodoroDetails `json:"-"`
+// UpdateTaskUpdateTask includes the requested fields of the GraphQL type Task.
+type UpdateTaskUpdateTask struct {
+	TaskDetails `json:"-"`
 }
 
-// GetId returns StopPomodoroStopPomodoro.Id, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetId() string { return v.PomodoroDetails.Id }
+// GetId returns UpdateTaskUpdateTask.Id, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetId() string { return v.TaskDetails.Id }
 
-// GetState returns StopPomodoroStopPomodoro.State, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetState() PomodoroState { return v.PomodoroDetails.State }
+// GetTitle returns UpdateTaskUpdateTask.Title, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetTitle() string { return v.TaskDetails.Title }
 
-// GetTaskId returns StopPomodoroStopPomodoro.TaskId, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetTaskId() string { return v.PomodoroDetails.TaskId }
+// GetProject returns UpdateTaskUpdateTask.Project, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetProject() string { return v.TaskDetails.Project }
 
-// GetStartTime returns StopPomodoroStopPomodoro.StartTime, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetStartTime() time.Time { return v.PomodoroDetails.StartTime }
+// GetTags returns UpdateTaskUpdateTask.Tags, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetTags() []string { return v.TaskDetails.Tags }
 
-// GetPhase returns StopPomodoroStopPomodoro.Phase, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetPhase() PomodoroPhase { return v.PomodoroDetails.Phase }
+// GetEstimatedPomodoros returns UpdateTaskUpdateTask.EstimatedPomodoros, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetEstimatedPomodoros() int { return v.TaskDetails.EstimatedPomodoros }
 
-// GetPhaseCount returns StopPomodoroStopPomodoro.PhaseCount, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetPhaseCount() int { return v.PomodoroDetails.PhaseCount }
+// GetNotes returns UpdateTaskUpdateTask.Notes, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetNotes() string { return v.TaskDetails.Notes }
 
-// GetRemainingTimeSec returns StopPomodoroStopPomodoro.RemainingTimeSec, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetRemainingTimeSec() int {
-	return v.PomodoroDetails.RemainingTimeSec
-}
+// GetStatus returns UpdateTaskUpdateTask.Status, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetStatus() TaskStatus { return v.TaskDetails.Status }
 
-// GetElapsedTimeSec returns StopPomodoroStopPomodoro.ElapsedTimeSec, and is useful for accessing the field via an interface.
-func (v *StopPomodoroStopPomodoro) GetElapsedTimeSec() int { return v.PomodoroDetails.ElapsedTimeSec }
+// GetCreatedAt returns UpdateTaskUpdateTask.CreatedAt, and is useful for accessing the field via an interface.
+func (v *UpdateTaskUpdateTask) GetCreatedAt() time.Time { return v.TaskDetails.CreatedAt }
 
-func (v *StopPomodoroStopPomodoro) UnmarshalJSON(b []byte) error {
+func (v *UpdateTaskUpdateTask) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*StopPomodoroStopPomodoro
+		*UpdateTaskUpdateTask
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.StopPomodoroStopPomodoro = v
+	firstPass.UpdateTaskUpdateTask = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1454,32 +3273,32 @@ func (v *StopPomodoroStopPomodoro) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.PomodoroDetails)
+		b, &v.TaskDetails)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalStopPomodoroStopPomodoro struct {
+type __premarshalUpdateTaskUpdateTask struct {
 	Id string `json:"id"`
 
-	State PomodoroState `json:"state"`
+	Title string `json:"title"`
 
-	TaskId string `json:"taskId"`
+	Project string `json:"project"`
 
-	StartTime time.Time `json:"startTime"`
+	Tags []string `json:"tags"`
 
-	Phase PomodoroPhase `json:"phase"`
+	EstimatedPomodoros int `json:"estimatedPomodoros"`
 
-	PhaseCount int `json:"phaseCount"`
+	Notes string `json:"notes"`
 
-	RemainingTimeSec int `json:"remainingTimeSec"`
+	Status TaskStatus `json:"status"`
 
-	ElapsedTimeSec int `json:"elapsedTimeSec"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
-func (v *StopPomodoroStopPomodoro) MarshalJSON() ([]byte, error) {
+func (v *UpdateTaskUpdateTask) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1487,43 +3306,43 @@ func (v *StopPomodoroStopPomodoro) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *StopPomodoroStopPomodoro) __premarshalJSON() (*__premarshalStopPomodoroStopPomodoro, error) {
-	var retval __premarshalStopPomodoroStopPomodoro
+func (v *UpdateTaskUpdateTask) __premarshalJSON() (*__premarshalUpdateTaskUpdateTask, error) {
+	var retval __premarshalUpdateTaskUpdateTask
 
-	retval.Id = v.PomodoroDetails.Id
-	retval.State = v.PomodoroDetails.State
-	retval.TaskId = v.PomodoroDetails.TaskId
-	retval.StartTime = v.PomodoroDetails.StartTime
-	retval.Phase = v.PomodoroDetails.Phase
-	retval.PhaseCount = v.PomodoroDetails.PhaseCount
-	retval.RemainingTimeSec = v.PomodoroDetails.RemainingTimeSec
-	retval.ElapsedTimeSec = v.PomodoroDetails.ElapsedTimeSec
+	retval.Id = v.TaskDetails.Id
+	retval.Title = v.TaskDetails.Title
+	retval.Project = v.TaskDetails.Project
+	retval.Tags = v.TaskDetails.Tags
+	retval.EstimatedPomodoros = v.TaskDetails.EstimatedPomodoros
+	retval.Notes = v.TaskDetails.Notes
+	retval.Status = v.TaskDetails.Status
+	retval.CreatedAt = v.TaskDetails.CreatedAt
 	return &retval, nil
 }
 
-// TaskDetails includes the GraphQL fields of Task requested by the fragment TaskDetails.
-type TaskDetails struct {
-	Id        string    `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"createdAt"`
+// __ArchiveTaskInput is used internally by genqlient
+type __ArchiveTaskInput struct {
+	Id string `json:"id"`
 }
 
-// GetId returns TaskDetails.Id, and is useful for accessing the field via an interface.
-func (v *TaskDetails) GetId() string { return v.Id }
+// GetId returns __ArchiveTaskInput.Id, and is useful for accessing the field via an interface.
+func (v *__ArchiveTaskInput) GetId() string { return v.Id }
 
-// GetTitle returns TaskDetails.Title, and is useful for accessing the field via an interface.
-func (v *TaskDetails) GetTitle() string { return v.Title }
+// __CompleteTaskInput is used internally by genqlient
+type __CompleteTaskInput struct {
+	Id string `json:"id"`
+}
 
-// GetCreatedAt returns TaskDetails.CreatedAt, and is useful for accessing the field via an interface.
-func (v *TaskDetails) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetId returns __CompleteTaskInput.Id, and is useful for accessing the field via an interface.
+func (v *__CompleteTaskInput) GetId() string { return v.Id }
 
 // __CreateTaskInput is used internally by genqlient
 type __CreateTaskInput struct {
-	Title string `json:"title"`
+	Input CreateTaskInput `json:"input"`
 }
 
-// GetTitle returns __CreateTaskInput.Title, and is useful for accessing the field via an interface.
-func (v *__CreateTaskInput) GetTitle() string { return v.Title }
+// GetInput returns __CreateTaskInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateTaskInput) GetInput() CreateTaskInput { return v.Input }
 
 // __DeleteTaskInput is used internally by genqlient
 type __DeleteTaskInput struct {
@@ -1533,6 +3352,34 @@ type __DeleteTaskInput struct {
 // GetId returns __DeleteTaskInput.Id, and is useful for accessing the field via an interface.
 func (v *__DeleteTaskInput) GetId() string { return v.Id }
 
+// __GetPomodoroHistoryInput is used internally by genqlient
+type __GetPomodoroHistoryInput struct {
+	Filter PomodoroFilter `json:"filter"`
+	First  int            `json:"first"`
+	After  *string        `json:"after"`
+}
+
+// GetFilter returns __GetPomodoroHistoryInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetPomodoroHistoryInput) GetFilter() PomodoroFilter { return v.Filter }
+
+// GetFirst returns __GetPomodoroHistoryInput.First, and is useful for accessing the field via an interface.
+func (v *__GetPomodoroHistoryInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetPomodoroHistoryInput.After, and is useful for accessing the field via an interface.
+func (v *__GetPomodoroHistoryInput) GetAfter() *string { return v.After }
+
+// __GetStatsInput is used internally by genqlient
+type __GetStatsInput struct {
+	StatsRange StatsRange   `json:"statsRange"`
+	GroupBy    StatsGroupBy `json:"groupBy"`
+}
+
+// GetStatsRange returns __GetStatsInput.StatsRange, and is useful for accessing the field via an interface.
+func (v *__GetStatsInput) GetStatsRange() StatsRange { return v.StatsRange }
+
+// GetGroupBy returns __GetStatsInput.GroupBy, and is useful for accessing the field via an interface.
+func (v *__GetStatsInput) GetGroupBy() StatsGroupBy { return v.GroupBy }
+
 // __GetTaskInput is used internally by genqlient
 type __GetTaskInput struct {
 	Id string `json:"id"`
@@ -1541,6 +3388,14 @@ type __GetTaskInput struct {
 // GetId returns __GetTaskInput.Id, and is useful for accessing the field via an interface.
 func (v *__GetTaskInput) GetId() string { return v.Id }
 
+// __ListTasksInput is used internally by genqlient
+type __ListTasksInput struct {
+	Filter TaskFilter `json:"filter"`
+}
+
+// GetFilter returns __ListTasksInput.Filter, and is useful for accessing the field via an interface.
+func (v *__ListTasksInput) GetFilter() TaskFilter { return v.Filter }
+
 // __OnEventReceivedInput is used internally by genqlient
 type __OnEventReceivedInput struct {
 	Input EventReceivedInput `json:"input"`
@@ -1557,16 +3412,129 @@ type __StartPomodoroInput struct {
 // GetInput returns __StartPomodoroInput.Input, and is useful for accessing the field via an interface.
 func (v *__StartPomodoroInput) GetInput() StartPomodoroInput { return v.Input }
 
+// __UpdateTaskInput is used internally by genqlient
+type __UpdateTaskInput struct {
+	Input UpdateTaskInput `json:"input"`
+}
+
+// GetInput returns __UpdateTaskInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateTaskInput) GetInput() UpdateTaskInput { return v.Input }
+
+// __UpdateTaskStatusInput is used internally by genqlient
+type __UpdateTaskStatusInput struct {
+	Id     string     `json:"id"`
+	Status TaskStatus `json:"status"`
+}
+
+// GetId returns __UpdateTaskStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__UpdateTaskStatusInput) GetId() string { return v.Id }
+
+// GetStatus returns __UpdateTaskStatusInput.Status, and is useful for accessing the field via an interface.
+func (v *__UpdateTaskStatusInput) GetStatus() TaskStatus { return v.Status }
+
+// The mutation executed by ArchiveTask.
+const ArchiveTask_Operation = `
+mutation ArchiveTask ($id: ID!) {
+	archiveTask(id: $id) {
+		... TaskDetails
+	}
+}
+fragment TaskDetails on Task {
+	id
+	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
+	createdAt
+}
+`
+
+func ArchiveTask(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *ArchiveTaskResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ArchiveTask",
+		Query:  ArchiveTask_Operation,
+		Variables: &__ArchiveTaskInput{
+			Id: id,
+		},
+	}
+
+	data_ = &ArchiveTaskResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CompleteTask.
+const CompleteTask_Operation = `
+mutation CompleteTask ($id: ID!) {
+	completeTask(id: $id) {
+		... TaskDetails
+	}
+}
+fragment TaskDetails on Task {
+	id
+	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
+	createdAt
+}
+`
+
+func CompleteTask(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *CompleteTaskResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CompleteTask",
+		Query:  CompleteTask_Operation,
+		Variables: &__CompleteTaskInput{
+			Id: id,
+		},
+	}
+
+	data_ = &CompleteTaskResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by CreateTask.
 const CreateTask_Operation = `
-mutation CreateTask ($title: String!) {
-	createTask(input: {title:$title}) {
+mutation CreateTask ($input: CreateTaskInput!) {
+	createTask(input: $input) {
 		... TaskDetails
 	}
 }
 fragment TaskDetails on Task {
 	id
 	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
 	createdAt
 }
 `
@@ -1574,13 +3542,13 @@ fragment TaskDetails on Task {
 func CreateTask(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	title string,
+	input CreateTaskInput,
 ) (data_ *CreateTaskResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "CreateTask",
 		Query:  CreateTask_Operation,
 		Variables: &__CreateTaskInput{
-			Title: title,
+			Input: input,
 		},
 	}
 
@@ -1643,6 +3611,11 @@ query GetAllTasks {
 fragment TaskDetails on Task {
 	id
 	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
 	createdAt
 }
 `
@@ -1684,6 +3657,10 @@ fragment PomodoroDetails on Pomodoro {
 	phaseCount
 	remainingTimeSec
 	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
 }
 `
 
@@ -1708,6 +3685,180 @@ func GetCurrentPomodoro(
 	return data_, err_
 }
 
+// The query executed by GetCurrentStatus.
+const GetCurrentStatus_Operation = `
+query GetCurrentStatus {
+	currentPomodoro {
+		... PomodoroDetails
+		task {
+			... TaskDetails
+		}
+	}
+}
+fragment PomodoroDetails on Pomodoro {
+	id
+	state
+	taskId
+	startTime
+	phase
+	phaseCount
+	remainingTimeSec
+	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
+}
+fragment TaskDetails on Task {
+	id
+	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
+	createdAt
+}
+`
+
+func GetCurrentStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *GetCurrentStatusResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetCurrentStatus",
+		Query:  GetCurrentStatus_Operation,
+	}
+
+	data_ = &GetCurrentStatusResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetPomodoroHistory.
+const GetPomodoroHistory_Operation = `
+query GetPomodoroHistory ($filter: PomodoroFilter!, $first: Int!, $after: String) {
+	pomodoros(filter: $filter, first: $first, after: $after) {
+		totalCount
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		edges {
+			node {
+				... PomodoroDetails
+			}
+		}
+	}
+}
+fragment PomodoroDetails on Pomodoro {
+	id
+	state
+	taskId
+	startTime
+	phase
+	phaseCount
+	remainingTimeSec
+	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
+}
+`
+
+func GetPomodoroHistory(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter PomodoroFilter,
+	first int,
+	after *string,
+) (data_ *GetPomodoroHistoryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetPomodoroHistory",
+		Query:  GetPomodoroHistory_Operation,
+		Variables: &__GetPomodoroHistoryInput{
+			Filter: filter,
+			First:  first,
+			After:  after,
+		},
+	}
+
+	data_ = &GetPomodoroHistoryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetStats.
+const GetStats_Operation = `
+query GetStats ($statsRange: StatsRange!, $groupBy: StatsGroupBy!) {
+	stats(range: $statsRange, groupBy: $groupBy) {
+		... StatsDetails
+	}
+}
+fragment StatsDetails on Stats {
+	start
+	end
+	focusTimeSec
+	sessions
+	interruptions
+	breakAdherence
+	groups {
+		... StatsGroupDetails
+	}
+}
+fragment StatsGroupDetails on StatsGroup {
+	key
+	start
+	end
+	focusTimeSec
+	sessions
+	interruptions
+	breakAdherence
+}
+`
+
+func GetStats(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	statsRange StatsRange,
+	groupBy StatsGroupBy,
+) (data_ *GetStatsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetStats",
+		Query:  GetStats_Operation,
+		Variables: &__GetStatsInput{
+			StatsRange: statsRange,
+			GroupBy:    groupBy,
+		},
+	}
+
+	data_ = &GetStatsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by GetTask.
 const GetTask_Operation = `
 query GetTask ($id: ID!) {
@@ -1718,6 +3869,11 @@ query GetTask ($id: ID!) {
 fragment TaskDetails on Task {
 	id
 	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
 	createdAt
 }
 `
@@ -1747,6 +3903,55 @@ func GetTask(
 	return data_, err_
 }
 
+// The query executed by ListTasks.
+const ListTasks_Operation = `
+query ListTasks ($filter: TaskFilter!) {
+	tasks(filter: $filter) {
+		totalCount
+		edges {
+			node {
+				... TaskDetails
+			}
+		}
+	}
+}
+fragment TaskDetails on Task {
+	id
+	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
+	createdAt
+}
+`
+
+func ListTasks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter TaskFilter,
+) (data_ *ListTasksResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListTasks",
+		Query:  ListTasks_Operation,
+		Variables: &__ListTasksInput{
+			Filter: filter,
+		},
+	}
+
+	data_ = &ListTasksResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The subscription executed by OnEventReceived.
 const OnEventReceived_Operation = `
 subscription OnEventReceived ($input: EventReceivedInput!) {
@@ -1757,6 +3962,7 @@ subscription OnEventReceived ($input: EventReceivedInput!) {
 fragment EventDetails on Event {
 	eventCategory
 	eventType
+	sequence
 	payload {
 		__typename
 		... EventPomodoroPayloadDetails
@@ -1771,10 +3977,17 @@ fragment EventPomodoroPayloadDetails on EventPomodoroPayload {
 	taskId
 	phase
 	phaseCount
+	phaseDurationSec
+	breakFrequency
 }
 fragment EventTaskPayloadDetails on EventTaskPayload {
 	id
 	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
 }
 `
 
@@ -1839,6 +4052,10 @@ fragment PomodoroDetails on Pomodoro {
 	phaseCount
 	remainingTimeSec
 	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
 }
 `
 
@@ -1879,6 +4096,10 @@ fragment PomodoroDetails on Pomodoro {
 	phaseCount
 	remainingTimeSec
 	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
 }
 `
 
@@ -1919,6 +4140,10 @@ fragment PomodoroDetails on Pomodoro {
 	phaseCount
 	remainingTimeSec
 	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
 }
 `
 
@@ -1959,6 +4184,10 @@ fragment PomodoroDetails on Pomodoro {
 	phaseCount
 	remainingTimeSec
 	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
 }
 `
 
@@ -2003,6 +4232,10 @@ fragment PomodoroDetails on Pomodoro {
 	phaseCount
 	remainingTimeSec
 	elapsedTimeSec
+	phaseDurationSec
+	breakFrequency
+	endTime
+	autoAdvance
 }
 `
 
@@ -2026,3 +4259,93 @@ func StopPomodoro(
 
 	return data_, err_
 }
+
+// The mutation executed by UpdateTask.
+const UpdateTask_Operation = `
+mutation UpdateTask ($input: UpdateTaskInput!) {
+	updateTask(input: $input) {
+		... TaskDetails
+	}
+}
+fragment TaskDetails on Task {
+	id
+	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
+	createdAt
+}
+`
+
+func UpdateTask(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateTaskInput,
+) (data_ *UpdateTaskResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateTask",
+		Query:  UpdateTask_Operation,
+		Variables: &__UpdateTaskInput{
+			Input: input,
+		},
+	}
+
+	data_ = &UpdateTaskResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateTaskStatus.
+const UpdateTaskStatus_Operation = `
+mutation UpdateTaskStatus ($id: ID!, $status: TaskStatus!) {
+	updateTaskStatus(id: $id, status: $status) {
+		... TaskDetails
+	}
+}
+fragment TaskDetails on Task {
+	id
+	title
+	project
+	tags
+	estimatedPomodoros
+	notes
+	status
+	createdAt
+}
+`
+
+func UpdateTaskStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	status TaskStatus,
+) (data_ *UpdateTaskStatusResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateTaskStatus",
+		Query:  UpdateTaskStatus_Operation,
+		Variables: &__UpdateTaskStatusInput{
+			Id:     id,
+			Status: status,
+		},
+	}
+
+	data_ = &UpdateTaskStatusResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
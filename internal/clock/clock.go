@@ -0,0 +1,54 @@
+// Package clock abstracts the passage of time so that timers can be driven deterministically
+package clock
+
+import (
+	"time"
+)
+
+// Clock provides the current time and tickers.
+type Clock interface {
+	// Now returns the current time. Times returned by the real clock carry a monotonic reading.
+	Now() time.Time
+
+	// NewTicker returns a ticker that fires every d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks on a channel until it is stopped.
+type Ticker interface {
+	// C returns the channel on which ticks are delivered.
+	C() <-chan time.Time
+
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// New returns a Clock backed by the time package.
+//
+//nolint:ireturn
+func New() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+//nolint:ireturn
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{ticker: time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t *realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t *realTicker) Stop() {
+	t.ticker.Stop()
+}
@@ -0,0 +1,119 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is a Clock whose time only moves when Advance is called.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+// NewFake returns a Fake clock set to now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the current time of the fake clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// NewTicker returns a ticker that fires as the fake clock is advanced.
+//
+//nolint:ireturn
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := &fakeTicker{
+		clock:  f,
+		c:      make(chan time.Time, 1),
+		period: d,
+		next:   f.now.Add(d),
+	}
+	f.tickers = append(f.tickers, t)
+
+	return t
+}
+
+// Advance moves the clock forward by d, firing the tickers that become due on the way in order.
+// Like time.Ticker, a ticker whose previous tick has not been received yet drops the tick.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	target := f.now.Add(d)
+
+	for {
+		next := f.nextTicker(target)
+		if next == nil {
+			break
+		}
+
+		f.now = next.next
+		next.next = next.next.Add(next.period)
+
+		select {
+		case next.c <- f.now:
+		default:
+		}
+	}
+
+	f.now = target
+}
+
+// nextTicker returns the ticker that fires first at or before target, or nil if none does.
+func (f *Fake) nextTicker(target time.Time) *fakeTicker {
+	var next *fakeTicker
+
+	for _, t := range f.tickers {
+		if t.next.After(target) {
+			continue
+		}
+
+		if next == nil || t.next.Before(next.next) {
+			next = t
+		}
+	}
+
+	return next
+}
+
+func (f *Fake) removeTicker(t *fakeTicker) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, ticker := range f.tickers {
+		if ticker == t {
+			f.tickers = append(f.tickers[:i], f.tickers[i+1:]...)
+
+			return
+		}
+	}
+}
+
+type fakeTicker struct {
+	clock  *Fake
+	c      chan time.Time
+	period time.Duration
+	next   time.Time
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.removeTicker(t)
+}
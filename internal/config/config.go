@@ -2,6 +2,7 @@
 package config
 
 import (
+	"fmt"
 	"reflect"
 	"time"
 
@@ -20,6 +21,9 @@ const (
 	DefaultShortBreakSec = 300
 	// DefaultLongBreakSec default long break second.
 	DefaultLongBreakSec = 900
+	// DefaultBreakFrequency default number of work sessions between long breaks.
+	// It is the long break after every third work session that gomodoro took before the frequency was configurable.
+	DefaultBreakFrequency = 3
 
 	// DefaultLogFile default log file path.
 	DefaultLogFile = "~/.gomodoro/gomodoro.log"
@@ -27,25 +31,62 @@ const (
 	// DefaultStorageDir is default storage directory.
 	DefaultStorageDir = "~/.gomodoro"
 
+	// StorageDriverFile stores data as JSON files under the storage directory.
+	StorageDriverFile = "file"
+	// StorageDriverSQLite stores data in an embedded SQLite database under the storage directory.
+	StorageDriverSQLite = "sqlite"
+
 	// DefaultAPITimeout default timeout for API operations in seconds.
 	DefaultAPITimeout = 10
+	// DefaultEventBufferSize default number of events queued for each event subscriber.
+	DefaultEventBufferSize = 64
+	// DefaultEventOverflowPolicy default handling of an event subscriber that falls behind.
+	DefaultEventOverflowPolicy = "coalesce_ticks"
+
+	// DefaultHookTimeout default time a hook command may run before it is killed.
+	DefaultHookTimeout = 30 * time.Second
+	// DefaultHookMaxConcurrency default number of hook commands that run at the same time.
+	DefaultHookMaxConcurrency = 4
+
+	// DefaultSoundVolume default volume of the sounds in percent.
+	DefaultSoundVolume = 100
+
+	// DefaultNotificationTimeout default time a notification channel may take to send a notification.
+	DefaultNotificationTimeout = 10 * time.Second
+
+	// NotificationChannelDesktop shows a desktop notification.
+	NotificationChannelDesktop = "desktop"
+	// NotificationChannelBell rings the terminal bell of the server.
+	NotificationChannelBell = "bell"
+	// NotificationChannelWebhook posts the notification as JSON to a URL.
+	NotificationChannelWebhook = "webhook"
+	// NotificationChannelNtfy publishes the notification to an ntfy topic.
+	NotificationChannelNtfy = "ntfy"
+	// NotificationChannelGotify pushes the notification to a Gotify server.
+	NotificationChannelGotify = "gotify"
 )
 
 // Config config for gomodoro.
 type Config struct {
-	Pomodoro PomodoroConfig `mapstructure:"pomodoro"`
-	Toggl    TogglConfig    `mapstructure:"toggl"`
-	Color    ColorConfig    `mapstructure:"color"`
-	Pixela   PixelaConfig   `mapstructure:"pixela"`
-	LogFile  string         `mapstructure:"log_file"`
-	LogLevel zapcore.Level  `mapstructure:"log_level"`
-	API      APIConfig      `mapstructure:"api"`
-	Storage  StorageConfig  `mapstructure:"storage"`
+	Pomodoro PomodoroConfig  `mapstructure:"pomodoro"`
+	Toggl    TogglConfig     `mapstructure:"toggl"`
+	Color    ColorConfig     `mapstructure:"color"`
+	Pixela   PixelaConfig    `mapstructure:"pixela"`
+	LogFile  string          `mapstructure:"log_file"`
+	LogLevel zapcore.Level   `mapstructure:"log_level"`
+	API      APIConfig       `mapstructure:"api"`
+	Storage  StorageConfig   `mapstructure:"storage"`
+	Webhooks []WebhookConfig `mapstructure:"webhooks" validate:"dive"`
+	Hooks    HooksConfig     `mapstructure:"hooks"`
+
+	Notifications NotificationsConfig `mapstructure:"notifications"`
+	Sound         SoundConfig         `mapstructure:"sound"`
 }
 
 // StorageConfig contains configuration options for storage.
 type StorageConfig struct {
-	Dir string `mapstructure:"dir"`
+	Driver string `mapstructure:"driver" validate:"oneof=file sqlite"`
+	Dir    string `mapstructure:"dir"`
 }
 
 // APIConfig contains configuration options for the API server.
@@ -53,6 +94,107 @@ type APIConfig struct {
 	Addr         string        `mapstructure:"addr"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// EventBufferSize is the number of events queued for each event subscriber, e.g. a running TUI.
+	EventBufferSize int `mapstructure:"event_buffer_size" validate:"gt=0"`
+	// EventOverflowPolicy decides what happens to a subscriber whose queue is full.
+	// The disconnect policy only applies to API clients, the subscribers inside the server coalesce ticks instead.
+	EventOverflowPolicy string `mapstructure:"event_overflow_policy" validate:"oneof=drop_oldest coalesce_ticks disconnect"`
+}
+
+// WebhookConfig contains configuration options for an outgoing webhook.
+type WebhookConfig struct {
+	Name string   `mapstructure:"name" validate:"required"`
+	URLs []string `mapstructure:"urls" validate:"required,dive,http_url"`
+	// Events lists the event types to send, e.g. pomodoro.completed. Empty sends every event except ticks.
+	Events []string `mapstructure:"events"`
+	// Secret signs the payload with HMAC-SHA256 when it is set.
+	Secret string `mapstructure:"secret"`
+	// Template is a Go template that renders the JSON payload. Empty sends the event as JSON.
+	Template string `mapstructure:"template"`
+	// MaxAttempts is the number of attempts before a delivery goes to the dead-letter queue. 0 means the default.
+	MaxAttempts int `mapstructure:"max_attempts" validate:"gte=0"`
+	// Timeout is the timeout of each attempt. 0 means the default.
+	Timeout time.Duration `mapstructure:"timeout" validate:"gte=0"`
+}
+
+// HooksConfig contains configuration options for the shell commands run on events.
+type HooksConfig struct {
+	// Events maps an event type, e.g. pomodoro.started, to the commands run on it.
+	Events HookCommands `mapstructure:"events"`
+	// Timeout is the time a command may run before it is killed.
+	Timeout time.Duration `mapstructure:"timeout" validate:"gt=0"`
+	// MaxConcurrency is the number of commands that run at the same time. Further commands wait for a slot.
+	MaxConcurrency int `mapstructure:"max_concurrency" validate:"gt=0"`
+}
+
+// HookCommands maps event types to shell commands.
+type HookCommands map[string][]string
+
+// NotificationsConfig contains configuration options for the notifications sent when a phase ends.
+type NotificationsConfig struct {
+	Channels []NotificationChannelConfig `mapstructure:"channels" validate:"dive"`
+	Phases   NotificationPhasesConfig    `mapstructure:"phases"`
+	// Timeout is the time a channel may take to send a notification.
+	Timeout time.Duration `mapstructure:"timeout" validate:"gt=0"`
+}
+
+// HasChannel reports whether a channel of the type is configured, e.g. NotificationChannelDesktop.
+func (c NotificationsConfig) HasChannel(channelType string) bool {
+	for _, channel := range c.Channels {
+		if channel.Type == channelType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotificationChannelConfig contains configuration options for a notification channel.
+type NotificationChannelConfig struct {
+	Name string `mapstructure:"name" validate:"required"`
+	Type string `mapstructure:"type" validate:"oneof=desktop bell webhook ntfy gotify"`
+	// URL is the endpoint of webhook, the topic URL of ntfy, e.g. https://ntfy.sh/my-topic, or the server of Gotify.
+	URL string `mapstructure:"url" validate:"omitempty,http_url"`
+	// Token is sent as a bearer token, or as the application token of Gotify.
+	Token string `mapstructure:"token"`
+	// Priority is the priority of ntfy (1-5) and Gotify messages. 0 leaves it to the server.
+	Priority int `mapstructure:"priority" validate:"gte=0"`
+	// Template is a Go template that renders the JSON payload of webhook. Empty sends the notification as JSON.
+	Template string `mapstructure:"template"`
+}
+
+// NotificationPhasesConfig contains the notifications sent when each phase ends.
+type NotificationPhasesConfig struct {
+	Work       NotificationPhaseConfig `mapstructure:"work"`
+	ShortBreak NotificationPhaseConfig `mapstructure:"short_break"`
+	LongBreak  NotificationPhaseConfig `mapstructure:"long_break"`
+}
+
+// NotificationPhaseConfig contains the notification sent when a phase ends.
+type NotificationPhaseConfig struct {
+	// Channels lists the names of the channels to notify. Empty notifies every channel.
+	Channels []string `mapstructure:"channels"`
+	// Title and Message are Go templates, e.g. "{{ .Task.Title }} is done". Empty uses the default.
+	Title   string `mapstructure:"title"`
+	Message string `mapstructure:"message"`
+	// OnStop also notifies when the phase is stopped before it ends.
+	OnStop bool `mapstructure:"on_stop"`
+}
+
+// SoundConfig contains configuration options for the sounds played by the TUI.
+type SoundConfig struct {
+	// Volume is the volume in percent.
+	Volume int `mapstructure:"volume" validate:"gte=0,lte=100"`
+	// Mute starts the TUI without sounds. They can be turned on with the m key.
+	Mute bool `mapstructure:"mute"`
+	// PhaseEnd is a WAV or Ogg Vorbis file played when a phase ends. Empty plays a built-in chime.
+	PhaseEnd string `mapstructure:"phase_end"`
+	// BreakEnd is played instead of PhaseEnd when a break ends. Empty plays PhaseEnd if it is set,
+	// or a built-in chime.
+	BreakEnd string `mapstructure:"break_end"`
+	// Tick is played every second of a work phase when TickEnable is true. Empty plays a built-in click.
+	Tick       string `mapstructure:"tick"`
+	TickEnable bool   `mapstructure:"tick_enable"`
 }
 
 // PomodoroConfig config for pomodoro.
@@ -102,7 +244,7 @@ func DefaultConfig() *Config {
 			WorkSec:        DefaultWorkSec,
 			ShortBreakSec:  DefaultShortBreakSec,
 			LongBreakSec:   DefaultLongBreakSec,
-			BreakFrequency: 2, //nolint:mnd
+			BreakFrequency: DefaultBreakFrequency,
 		},
 		LogFile: DefaultLogFile,
 		Color: ColorConfig{
@@ -116,12 +258,25 @@ func DefaultConfig() *Config {
 			Cursor:              tcell.ColorGreen,
 		},
 		API: APIConfig{
-			Addr:         "localhost:8080",
-			ReadTimeout:  time.Second * DefaultAPITimeout,
-			WriteTimeout: time.Second * DefaultAPITimeout,
+			Addr:                "localhost:8080",
+			ReadTimeout:         time.Second * DefaultAPITimeout,
+			WriteTimeout:        time.Second * DefaultAPITimeout,
+			EventBufferSize:     DefaultEventBufferSize,
+			EventOverflowPolicy: DefaultEventOverflowPolicy,
+		},
+		Hooks: HooksConfig{
+			Timeout:        DefaultHookTimeout,
+			MaxConcurrency: DefaultHookMaxConcurrency,
+		},
+		Notifications: NotificationsConfig{
+			Timeout: DefaultNotificationTimeout,
+		},
+		Sound: SoundConfig{
+			Volume: DefaultSoundVolume,
 		},
 		Storage: StorageConfig{
-			Dir: DefaultStorageDir,
+			Driver: StorageDriverFile,
+			Dir:    DefaultStorageDir,
 		},
 	}
 }
@@ -133,8 +288,11 @@ func GetConfig() (*Config, error) {
 	err := viper.Unmarshal(&c,
 		viper.DecodeHook(
 			mapstructure.ComposeDecodeHookFunc(
+				// A custom DecodeHook replaces viper's default ones, so durations such as "10s" are decoded here.
+				mapstructure.StringToTimeDurationHookFunc(),
 				tcellColorDecodeHook(),
 				zapcoreLevelDecodeHook(),
+				hookCommandsDecodeHook(),
 			),
 		),
 	)
@@ -158,6 +316,12 @@ func GetConfig() (*Config, error) {
 		return nil, err
 	}
 
+	for _, path := range []*string{&c.Sound.PhaseEnd, &c.Sound.BreakEnd, &c.Sound.Tick} {
+		if *path, err = homedir.Expand(*path); err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
@@ -193,3 +357,39 @@ func zapcoreLevelDecodeHook() mapstructure.DecodeHookFunc {
 		return data, nil
 	}
 }
+
+// hookCommandsDecodeHook restores the event types of HookCommands.
+// viper splits keys on dots, so "pomodoro.started" arrives as {"pomodoro": {"started": ...}}.
+func hookCommandsDecodeHook() mapstructure.DecodeHookFunc {
+	return func(_ reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if t != reflect.TypeOf(HookCommands(nil)) {
+			return data, nil
+		}
+
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return data, nil
+		}
+
+		flat := make(map[string]interface{})
+		flattenKeys(flat, "", m)
+
+		return flat, nil
+	}
+}
+
+func flattenKeys(dst map[string]interface{}, prefix string, m map[string]interface{}) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = fmt.Sprintf("%s.%s", prefix, k)
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenKeys(dst, key, nested)
+			continue
+		}
+
+		dst[key] = v
+	}
+}
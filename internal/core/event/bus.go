@@ -6,6 +6,8 @@ package event
 //nolint:revive
 type EventInfo interface {
 	GetEventType() EventType
+	// GetSequence returns the sequence number of the event in the event log, or 0 if it was not recorded
+	GetSequence() int64
 }
 
 // Handler is a function that handles an event.
@@ -19,15 +21,18 @@ type EventBus interface {
 	Publish(event EventInfo)
 
 	// Subscribe registers a handler for a specific event type
-	Subscribe(eventType EventType, handler Handler) string
+	Subscribe(eventType EventType, handler Handler, opts ...SubscribeOption) string
 
 	// Unsubscribe removes a handler for a specific event type using the subscription ID
 	Unsubscribe(subscriptionID string)
 
 	// SubscribeMulti registers a handler for multiple event types and returns a slice of subscription IDs
-	SubscribeMulti(eventTypes []EventType, handler Handler) []string
+	SubscribeMulti(eventTypes []EventType, handler Handler, opts ...SubscribeOption) []string
 
 	// SubscribeChannel registers handlers for multiple event types
 	// Returns a channel to receive events and an unsubscribe function
-	SubscribeChannel(eventTypes []EventType) (<-chan interface{}, func())
+	SubscribeChannel(eventTypes []EventType, opts ...SubscribeOption) (<-chan interface{}, func())
+
+	// Stats returns the delivery counters of the bus
+	Stats() BusStats
 }
@@ -2,97 +2,366 @@
 package event
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
+
+	"github.com/hatappi/go-kit/log"
 )
 
 const (
-	defaultChannelBufferSize = 10
+	// DefaultBufferSize is the number of events queued for a subscriber by default.
+	DefaultBufferSize = 64
 )
 
+// OverflowPolicy decides what happens when an event is published to a subscriber whose queue is full.
+type OverflowPolicy string
+
+const (
+	// OverflowDropOldest drops the oldest queued event to make room for the new one.
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowCoalesceTicks drops the oldest queued tick, which the newer events supersede,
+	// and only drops the oldest event if no tick is queued.
+	OverflowCoalesceTicks OverflowPolicy = "coalesce_ticks"
+	// OverflowDisconnect unsubscribes an external subscriber and drops its queued events.
+	// A channel subscriber sees its channel closed.
+	// Subscribers inside the server cannot subscribe again, so they are handled like OverflowCoalesceTicks.
+	OverflowDisconnect OverflowPolicy = "disconnect"
+)
+
+// OverflowPolicies lists the supported overflow policies.
+var OverflowPolicies = []OverflowPolicy{OverflowDropOldest, OverflowCoalesceTicks, OverflowDisconnect}
+
+// BusStats holds the counters of an event bus since it was created.
+type BusStats struct {
+	// Subscribers is the number of current subscriptions.
+	Subscribers int
+	// Published is the number of published events.
+	Published uint64
+	// Delivered is the number of events handed to subscribers.
+	Delivered uint64
+	// Dropped is the number of events that were discarded because a subscriber fell behind.
+	Dropped uint64
+	// Disconnected is the number of subscriptions closed by OverflowDisconnect.
+	Disconnected uint64
+}
+
+// Option represents a function that configures the InMemoryBus.
+type Option func(*InMemoryBus)
+
+// WithBufferSize sets the number of events queued for each subscriber. Values below 1 are ignored.
+func WithBufferSize(size int) Option {
+	return func(b *InMemoryBus) {
+		if size > 0 {
+			b.bufferSize = size
+		}
+	}
+}
+
+// WithOverflowPolicy sets what happens when a subscriber's queue is full. An empty policy is ignored.
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return func(b *InMemoryBus) {
+		if policy != "" {
+			b.overflowPolicy = policy
+		}
+	}
+}
+
+// SubscribeOption represents a function that configures a single subscription.
+type SubscribeOption func(*subscription)
+
+// WithSubscriberName names the subscriber in the logs of the bus.
+func WithSubscriberName(name string) SubscribeOption {
+	return func(s *subscription) {
+		s.name = name
+	}
+}
+
+// WithExternalSubscriber marks a subscriber outside the server, e.g. a GraphQL client,
+// which can subscribe again when OverflowDisconnect ends its subscription.
+func WithExternalSubscriber() SubscribeOption {
+	return func(s *subscription) {
+		s.external = true
+	}
+}
+
 // InMemoryBus implements EventBus interface with in-memory event distribution.
+// Each subscription has a bounded queue drained by its own goroutine,
+// so a subscriber receives events in the order they were published and a slow subscriber never blocks Publish.
 type InMemoryBus struct {
-	subscribers map[EventType]map[string]Handler
-	mu          sync.RWMutex
-	idCounter   int
+	subscriptions  map[string]*subscription
+	mu             sync.RWMutex
+	idCounter      int
+	bufferSize     int
+	overflowPolicy OverflowPolicy
+
+	published    atomic.Uint64
+	delivered    atomic.Uint64
+	dropped      atomic.Uint64
+	disconnected atomic.Uint64
 }
 
 // NewInMemoryBus creates and initializes a new InMemoryBus instance.
-func NewInMemoryBus() *InMemoryBus {
-	return &InMemoryBus{
-		subscribers: make(map[EventType]map[string]Handler),
+func NewInMemoryBus(opts ...Option) *InMemoryBus {
+	b := &InMemoryBus{
+		subscriptions:  make(map[string]*subscription),
+		bufferSize:     DefaultBufferSize,
+		overflowPolicy: OverflowCoalesceTicks,
 	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
 }
 
-// Publish sends an event to all subscribers of that event type.
+// Publish queues an event for all subscribers of that event type.
 func (b *InMemoryBus) Publish(event EventInfo) {
+	b.published.Add(1)
+
+	var overflowed []*subscription
+
 	b.mu.RLock()
-	defer b.mu.RUnlock()
+	for _, sub := range b.subscriptions {
+		if !slices.Contains(sub.eventTypes, event.GetEventType()) {
+			continue
+		}
 
-	eventType := event.GetEventType()
+		if !sub.enqueue(event) {
+			overflowed = append(overflowed, sub)
+		}
+	}
+	b.mu.RUnlock()
 
-	if handlers, exists := b.subscribers[eventType]; exists {
-		for _, handler := range handlers {
-			go handler(event)
+	for _, sub := range overflowed {
+		if b.remove(sub.id) {
+			b.disconnected.Add(1)
+			log.FromContext(context.Background()).Info(
+				"Disconnected an event subscriber that fell behind",
+				"id", sub.id, "name", sub.name, "bufferSize", b.bufferSize,
+			)
 		}
 	}
 }
 
-// SubscribeMulti registers a handler for multiple event types and returns subscription IDs.
-func (b *InMemoryBus) SubscribeMulti(eventTypes []EventType, handler Handler) []string {
-	subscriptionIDs := make([]string, 0, len(eventTypes))
-	for _, eventType := range eventTypes {
-		id := b.Subscribe(eventType, handler)
-		subscriptionIDs = append(subscriptionIDs, id)
-	}
+// SubscribeMulti registers a handler for multiple event types.
+// The handler receives the events of all the types in order through a single subscription,
+// so the returned slice holds one subscription ID.
+func (b *InMemoryBus) SubscribeMulti(eventTypes []EventType, handler Handler, opts ...SubscribeOption) []string {
+	sub := b.newSubscription(eventTypes, opts)
+	sub.handler = handler
 
-	return subscriptionIDs
+	b.add(sub)
+
+	return []string{sub.id}
 }
 
 // SubscribeChannel returns the channel and an unsubscribe function.
-func (b *InMemoryBus) SubscribeChannel(eventTypes []EventType) (<-chan interface{}, func()) {
-	ch := make(chan interface{}, defaultChannelBufferSize)
-	handler := func(e interface{}) {
-		ch <- e
+// The channel is closed once the subscription ends, either by unsubscribe or by OverflowDisconnect.
+func (b *InMemoryBus) SubscribeChannel(eventTypes []EventType, opts ...SubscribeOption) (<-chan interface{}, func()) {
+	ch := make(chan interface{})
+
+	sub := b.newSubscription(eventTypes, opts)
+	sub.handler = func(e interface{}) {
+		select {
+		case ch <- e:
+		case <-sub.done:
+		}
+	}
+	// Only the subscription goroutine sends to the channel, so closing it there never races with a send.
+	sub.onStop = func() {
+		close(ch)
 	}
 
-	subscriptionIDs := b.SubscribeMulti(eventTypes, handler)
+	b.add(sub)
 
 	unsubscribe := func() {
-		for _, id := range subscriptionIDs {
-			b.Unsubscribe(id)
-		}
-		close(ch)
+		b.Unsubscribe(sub.id)
 	}
 
 	return ch, unsubscribe
 }
 
 // Subscribe registers a handler for a specific event type and returns a subscription ID.
-func (b *InMemoryBus) Subscribe(eventType EventType, handler Handler) string {
-	b.mu.Lock()
-	defer b.mu.Unlock()
+func (b *InMemoryBus) Subscribe(eventType EventType, handler Handler, opts ...SubscribeOption) string {
+	return b.SubscribeMulti([]EventType{eventType}, handler, opts...)[0]
+}
 
-	if _, exists := b.subscribers[eventType]; !exists {
-		b.subscribers[eventType] = make(map[string]Handler)
+// Unsubscribe removes a subscription using the subscription ID.
+// Events still queued for the subscription are discarded. It is safe to call more than once.
+func (b *InMemoryBus) Unsubscribe(subscriptionID string) {
+	b.remove(subscriptionID)
+}
+
+// Stats returns the counters of the bus.
+func (b *InMemoryBus) Stats() BusStats {
+	b.mu.RLock()
+	subscribers := len(b.subscriptions)
+	b.mu.RUnlock()
+
+	return BusStats{
+		Subscribers:  subscribers,
+		Published:    b.published.Load(),
+		Delivered:    b.delivered.Load(),
+		Dropped:      b.dropped.Load(),
+		Disconnected: b.disconnected.Load(),
 	}
+}
 
+func (b *InMemoryBus) newSubscription(eventTypes []EventType, opts []SubscribeOption) *subscription {
+	b.mu.Lock()
 	b.idCounter++
-	id := fmt.Sprintf("%s-%d", eventType, b.idCounter)
-	b.subscribers[eventType][id] = handler
+	id := fmt.Sprintf("subscription-%d", b.idCounter)
+	b.mu.Unlock()
+
+	sub := &subscription{
+		id:         id,
+		eventTypes: slices.Clone(eventTypes),
+		bus:        b,
+		wake:       make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
 
-	return id
+	for _, opt := range opts {
+		opt(sub)
+	}
+
+	return sub
 }
 
-// Unsubscribe removes a handler for a specific event type using the subscription ID.
-func (b *InMemoryBus) Unsubscribe(subscriptionID string) {
+func (b *InMemoryBus) add(sub *subscription) {
 	b.mu.Lock()
-	defer b.mu.Unlock()
+	b.subscriptions[sub.id] = sub
+	b.mu.Unlock()
+
+	go sub.run()
+}
+
+// remove ends a subscription and reports whether it was still subscribed.
+func (b *InMemoryBus) remove(subscriptionID string) bool {
+	b.mu.Lock()
+	sub, exists := b.subscriptions[subscriptionID]
+	delete(b.subscriptions, subscriptionID)
+	b.mu.Unlock()
+
+	if exists {
+		sub.stop()
+	}
+
+	return exists
+}
+
+// subscription queues the events of one subscriber and hands them to its handler in order.
+type subscription struct {
+	id         string
+	name       string
+	external   bool
+	eventTypes []EventType
+	handler    Handler
+	onStop     func()
+	bus        *InMemoryBus
+
+	mu      sync.Mutex
+	queue   []EventInfo
+	stopped bool
+
+	wake chan struct{}
+	done chan struct{}
+}
+
+// enqueue queues an event according to the overflow policy of the bus.
+// It returns false if the subscription has to be disconnected.
+func (s *subscription) enqueue(event EventInfo) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return true
+	}
+
+	if len(s.queue) >= s.bus.bufferSize {
+		policy := s.bus.overflowPolicy
+		if policy == OverflowDisconnect && !s.external {
+			policy = OverflowCoalesceTicks
+		}
+
+		switch policy {
+		case OverflowDisconnect:
+			s.bus.dropped.Add(uint64(len(s.queue)) + 1)
+			s.queue = nil
+			return false
+		case OverflowCoalesceTicks:
+			i := slices.IndexFunc(s.queue, func(e EventInfo) bool { return e.GetEventType() == PomodoroTick })
+			s.queue = slices.Delete(s.queue, max(i, 0), max(i, 0)+1)
+		case OverflowDropOldest:
+			s.queue = slices.Delete(s.queue, 0, 1)
+		}
+
+		s.bus.dropped.Add(1)
+	}
+
+	s.queue = append(s.queue, event)
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+// next takes the oldest queued event. It returns false if the queue is empty or the subscription is stopped.
+func (s *subscription) next() (EventInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped || len(s.queue) == 0 {
+		return nil, false
+	}
+
+	event := s.queue[0]
+	s.queue[0] = nil
+	s.queue = s.queue[1:]
 
-	for eventType, handlers := range b.subscribers {
-		if _, exists := handlers[subscriptionID]; exists {
-			delete(b.subscribers[eventType], subscriptionID)
+	return event, true
+}
+
+func (s *subscription) run() {
+	if s.onStop != nil {
+		defer s.onStop()
+	}
+
+	for {
+		select {
+		case <-s.done:
 			return
+		case <-s.wake:
+		}
+
+		for {
+			event, ok := s.next()
+			if !ok {
+				break
+			}
+
+			s.bus.delivered.Add(1)
+			s.handler(event)
 		}
 	}
 }
+
+func (s *subscription) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return
+	}
+
+	s.stopped = true
+	s.queue = nil
+	close(s.done)
+}
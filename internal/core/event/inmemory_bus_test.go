@@ -0,0 +1,248 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/core/event"
+)
+
+func tick(remaining time.Duration) event.PomodoroEvent {
+	return event.PomodoroEvent{BaseEvent: event.BaseEvent{Type: event.PomodoroTick}, RemainingTime: remaining}
+}
+
+func started(id string) event.PomodoroEvent {
+	return event.PomodoroEvent{BaseEvent: event.BaseEvent{Type: event.PomodoroStarted}, ID: id}
+}
+
+func receive(t *testing.T, ch <-chan interface{}) event.PomodoroEvent {
+	t.Helper()
+
+	select {
+	case e, ok := <-ch:
+		if !ok {
+			t.Fatal("channel closed, want an event")
+		}
+
+		pe, ok := e.(event.PomodoroEvent)
+		if !ok {
+			t.Fatalf("unexpected event %T", e)
+		}
+
+		return pe
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	return event.PomodoroEvent{}
+}
+
+func waitClosed(t *testing.T, ch <-chan interface{}) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for the channel to be closed")
+		}
+	}
+}
+
+// blockedSubscriber subscribes a handler that holds the first event until release is closed,
+// so that the following events stay in the queue.
+func blockedSubscriber(t *testing.T, bus *event.InMemoryBus) (<-chan event.PomodoroEvent, chan struct{}) {
+	t.Helper()
+
+	received := make(chan event.PomodoroEvent, 100)
+	release := make(chan struct{})
+
+	bus.SubscribeMulti([]event.EventType{event.PomodoroStarted, event.PomodoroTick}, func(e interface{}) {
+		received <- e.(event.PomodoroEvent) //nolint:forcetypeassert
+		<-release
+	})
+
+	bus.Publish(started("first"))
+
+	select {
+	case e := <-received:
+		if e.ID != "first" {
+			t.Fatalf("first event = %+v, want started first", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the first event")
+	}
+
+	return received, release
+}
+
+func collect(t *testing.T, received <-chan event.PomodoroEvent, n int) []event.PomodoroEvent {
+	t.Helper()
+
+	events := make([]event.PomodoroEvent, 0, n)
+	for range n {
+		select {
+		case e := <-received:
+			events = append(events, e)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after %d of %d events", len(events), n)
+		}
+	}
+
+	select {
+	case e := <-received:
+		t.Fatalf("unexpected extra event %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	return events
+}
+
+func TestInMemoryBusOrder(t *testing.T) {
+	t.Parallel()
+
+	bus := event.NewInMemoryBus(event.WithBufferSize(1000))
+
+	ch, unsubscribe := bus.SubscribeChannel([]event.EventType{event.PomodoroTick})
+	defer unsubscribe()
+
+	for i := range 1000 {
+		bus.Publish(tick(time.Duration(1000-i) * time.Second))
+	}
+
+	for i := range 1000 {
+		if got, want := receive(t, ch).RemainingTime, time.Duration(1000-i)*time.Second; got != want {
+			t.Fatalf("tick %d has remaining time %v, want %v", i, got, want)
+		}
+	}
+
+	stats := bus.Stats()
+	if stats.Published != 1000 || stats.Delivered != 1000 || stats.Dropped != 0 {
+		t.Errorf("Stats() = %+v, want 1000 published and delivered", stats)
+	}
+}
+
+func TestInMemoryBusOverflow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("drop oldest", func(t *testing.T) {
+		t.Parallel()
+
+		bus := event.NewInMemoryBus(event.WithBufferSize(2), event.WithOverflowPolicy(event.OverflowDropOldest))
+		received, release := blockedSubscriber(t, bus)
+
+		bus.Publish(started("a"))
+		bus.Publish(tick(3 * time.Second))
+		bus.Publish(started("b"))
+		bus.Publish(tick(time.Second))
+		close(release)
+
+		events := collect(t, received, 2)
+		if events[0].ID != "b" || events[1].RemainingTime != time.Second {
+			t.Errorf("received %+v, want started b and the last tick", events)
+		}
+
+		if dropped := bus.Stats().Dropped; dropped != 2 {
+			t.Errorf("Stats().Dropped = %d, want 2", dropped)
+		}
+	})
+
+	t.Run("coalesce ticks", func(t *testing.T) {
+		t.Parallel()
+
+		bus := event.NewInMemoryBus(event.WithBufferSize(2), event.WithOverflowPolicy(event.OverflowCoalesceTicks))
+		received, release := blockedSubscriber(t, bus)
+
+		bus.Publish(started("a"))
+		bus.Publish(tick(3 * time.Second))
+		bus.Publish(tick(2 * time.Second))
+		bus.Publish(tick(time.Second))
+		bus.Publish(started("b"))
+		close(release)
+
+		events := collect(t, received, 2)
+		if events[0].ID != "a" || events[1].ID != "b" {
+			t.Errorf("received %+v, want started a and b without ticks", events)
+		}
+
+		if dropped := bus.Stats().Dropped; dropped != 3 {
+			t.Errorf("Stats().Dropped = %d, want 3", dropped)
+		}
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		t.Parallel()
+
+		bus := event.NewInMemoryBus(event.WithBufferSize(2), event.WithOverflowPolicy(event.OverflowDisconnect))
+
+		ch, unsubscribe := bus.SubscribeChannel([]event.EventType{event.PomodoroTick}, event.WithExternalSubscriber())
+		defer unsubscribe()
+
+		// The first tick is held by the subscription goroutine, two are queued and the fourth overflows.
+		for i := range 4 {
+			bus.Publish(tick(time.Duration(i) * time.Second))
+		}
+
+		waitClosed(t, ch)
+
+		stats := bus.Stats()
+		if stats.Disconnected != 1 || stats.Subscribers != 0 {
+			t.Errorf("Stats() = %+v, want 1 disconnected and no subscribers", stats)
+		}
+	})
+
+	t.Run("disconnect keeps internal subscribers", func(t *testing.T) {
+		t.Parallel()
+
+		bus := event.NewInMemoryBus(event.WithBufferSize(2), event.WithOverflowPolicy(event.OverflowDisconnect))
+		received, release := blockedSubscriber(t, bus)
+
+		bus.Publish(started("a"))
+		bus.Publish(tick(2 * time.Second))
+		bus.Publish(tick(time.Second))
+		bus.Publish(started("b"))
+		close(release)
+
+		events := collect(t, received, 2)
+		if events[0].ID != "a" || events[1].ID != "b" {
+			t.Errorf("received %+v, want started a and b without ticks", events)
+		}
+
+		stats := bus.Stats()
+		if stats.Disconnected != 0 || stats.Subscribers != 1 {
+			t.Errorf("Stats() = %+v, want no disconnected and 1 subscriber", stats)
+		}
+	})
+}
+
+func TestInMemoryBusUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := event.NewInMemoryBus()
+
+	ch, unsubscribe := bus.SubscribeChannel([]event.EventType{event.PomodoroTick})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := range 1000 {
+			bus.Publish(tick(time.Duration(i) * time.Second))
+		}
+	}()
+
+	// Nobody reads the channel, so the subscription is blocked on a send when it ends.
+	unsubscribe()
+	unsubscribe()
+	<-done
+
+	waitClosed(t, ch)
+
+	if subscribers := bus.Stats().Subscribers; subscribers != 0 {
+		t.Errorf("Stats().Subscribers = %d, want 0", subscribers)
+	}
+}
@@ -32,19 +32,25 @@ const (
 	TaskUpdated EventType = "task.updated"
 	// TaskDeleted event is emitted when a task is deleted.
 	TaskDeleted EventType = "task.deleted"
+	// TaskCompleted event is emitted when a task is marked as done.
+	TaskCompleted EventType = "task.completed"
+	// TaskArchived event is emitted when a task is archived.
+	TaskArchived EventType = "task.archived"
 )
 
 // AllEventTypes contains a list of all available event types in the system.
 var AllEventTypes = []EventType{
 	PomodoroStarted, PomodoroPaused, PomodoroResumed,
 	PomodoroCompleted, PomodoroStopped, PomodoroReset, PomodoroTick,
-	TaskCreated, TaskUpdated, TaskDeleted,
+	TaskCreated, TaskUpdated, TaskDeleted, TaskCompleted, TaskArchived,
 }
 
 // BaseEvent contains common fields for all events.
 type BaseEvent struct {
 	Type      EventType `json:"type"`
 	Timestamp time.Time `json:"timestamp"`
+	// Sequence is the position of the event in the event log, or 0 if the event was not recorded.
+	Sequence int64 `json:"sequence,omitempty"`
 }
 
 // PomodoroState represents the state of a pomodoro session.
@@ -71,17 +77,32 @@ const (
 	PomodoroPhaseLongBreak PomodoroPhase = "long_break"
 )
 
+// TaskStatus represents the progress of a task.
+type TaskStatus string
+
+const (
+	// TaskStatusTodo indicates a task that has not been started.
+	TaskStatusTodo TaskStatus = "todo"
+	// TaskStatusInProgress indicates a task that is being worked on.
+	TaskStatusInProgress TaskStatus = "in_progress"
+	// TaskStatusDone indicates a finished task.
+	TaskStatusDone TaskStatus = "done"
+	// TaskStatusArchived indicates a task that is kept only for its history.
+	TaskStatusArchived TaskStatus = "archived"
+)
+
 // PomodoroEvent represents events related to pomodoro sessions.
 type PomodoroEvent struct {
 	BaseEvent
-	ID            string        `json:"id"`
-	State         PomodoroState `json:"state"`
-	RemainingTime time.Duration `json:"remaining_time"`
-	ElapsedTime   time.Duration `json:"elapsed_time"`
-	TaskID        string        `json:"task_id,omitempty"`
-	Phase         PomodoroPhase `json:"phase"`
-	PhaseCount    int           `json:"phase_count"`
-	PhaseDuration time.Duration `json:"phase_duration"`
+	ID             string        `json:"id"`
+	State          PomodoroState `json:"state"`
+	RemainingTime  time.Duration `json:"remaining_time"`
+	ElapsedTime    time.Duration `json:"elapsed_time"`
+	TaskID         string        `json:"task_id,omitempty"`
+	Phase          PomodoroPhase `json:"phase"`
+	PhaseCount     int           `json:"phase_count"`
+	PhaseDuration  time.Duration `json:"phase_duration"`
+	BreakFrequency int           `json:"break_frequency"`
 }
 
 // GetEventType returns the event type.
@@ -89,14 +110,29 @@ func (e PomodoroEvent) GetEventType() EventType {
 	return e.BaseEvent.Type
 }
 
+// GetSequence returns the sequence number of the event in the event log.
+func (e PomodoroEvent) GetSequence() int64 {
+	return e.BaseEvent.Sequence
+}
+
 // TaskEvent represents events related to tasks.
 type TaskEvent struct {
 	BaseEvent
-	ID    string `json:"id"`
-	Title string `json:"title"`
+	ID                 string     `json:"id"`
+	Title              string     `json:"title"`
+	Project            string     `json:"project,omitempty"`
+	Tags               []string   `json:"tags,omitempty"`
+	EstimatedPomodoros int        `json:"estimated_pomodoros,omitempty"`
+	Notes              string     `json:"notes,omitempty"`
+	Status             TaskStatus `json:"status"`
 }
 
 // GetEventType returns the event type.
 func (e TaskEvent) GetEventType() EventType {
 	return e.BaseEvent.Type
 }
+
+// GetSequence returns the sequence number of the event in the event log.
+func (e TaskEvent) GetSequence() int64 {
+	return e.BaseEvent.Sequence
+}
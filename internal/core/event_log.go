@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+// ErrEventsDropped is returned when the events to replay were already dropped from the event log.
+var ErrEventsDropped = errors.New("the events after the sequence were dropped from the event log")
+
+// EventLog is an event.EventBus that records published events in the storage before delivering them,
+// so that a subscriber which was not connected can replay what it missed.
+// Recorded events carry a monotonically increasing sequence number.
+// Tick events are only delivered live because they are published every second and are superseded by the next one.
+type EventLog struct {
+	event.EventBus
+
+	storage storage.EventStorage
+	mu      sync.Mutex
+}
+
+// NewEventLog creates a new EventLog that records events in storage and delivers them through bus.
+func NewEventLog(storage storage.EventStorage, bus event.EventBus) *EventLog {
+	return &EventLog{
+		EventBus: bus,
+		storage:  storage,
+	}
+}
+
+// Publish records the event and then sends it with its sequence number to all subscribers of that event type.
+// An event that cannot be recorded is still delivered, without a sequence number.
+func (l *EventLog) Publish(e event.EventInfo) {
+	if e.GetEventType() == event.PomodoroTick {
+		l.EventBus.Publish(e)
+		return
+	}
+
+	// The lock keeps events reaching the bus in the order of their sequence numbers.
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	recorded, err := l.record(e)
+	if err != nil {
+		log.FromContext(context.Background()).Error(err, "Failed to record event", "type", e.GetEventType())
+		recorded = e
+	}
+
+	l.EventBus.Publish(recorded)
+}
+
+// EventsSince retrieves the recorded events of the given types with a sequence number greater than sequence in order.
+// It returns ErrEventsDropped instead of a partial replay when the storage no longer has the event after sequence,
+// e.g. because the file storage dropped the oldest events of a large log.
+func (l *EventLog) EventsSince(sequence int64, eventTypes []event.EventType) ([]event.EventInfo, error) {
+	records, err := l.storage.GetEventsSince(sequence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	if len(records) > 0 && records[0].Sequence > sequence+1 {
+		return nil, fmt.Errorf("%w: oldest event is %d, want %d", ErrEventsDropped, records[0].Sequence, sequence+1)
+	}
+
+	events := make([]event.EventInfo, 0, len(records))
+	for _, r := range records {
+		if !slices.Contains(eventTypes, event.EventType(r.Type)) {
+			continue
+		}
+
+		e, err := decodeEvent(r)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+func (l *EventLog) record(e event.EventInfo) (event.EventInfo, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	r := &storage.Event{
+		Type:    string(e.GetEventType()),
+		Payload: payload,
+	}
+
+	switch evt := e.(type) {
+	case event.PomodoroEvent:
+		r.Timestamp = evt.Timestamp
+	case event.TaskEvent:
+		r.Timestamp = evt.Timestamp
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", e)
+	}
+
+	if err := l.storage.AppendEvent(r); err != nil {
+		return nil, fmt.Errorf("failed to append event: %w", err)
+	}
+
+	return withSequence(e, r.Sequence), nil
+}
+
+// decodeEvent restores the published event from its record.
+func decodeEvent(r *storage.Event) (event.EventInfo, error) {
+	var e event.EventInfo
+
+	switch {
+	case strings.HasPrefix(r.Type, "pomodoro."):
+		var pe event.PomodoroEvent
+		if err := json.Unmarshal(r.Payload, &pe); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pomodoro event %d: %w", r.Sequence, err)
+		}
+
+		e = pe
+	case strings.HasPrefix(r.Type, "task."):
+		var te event.TaskEvent
+		if err := json.Unmarshal(r.Payload, &te); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal task event %d: %w", r.Sequence, err)
+		}
+
+		e = te
+	default:
+		return nil, fmt.Errorf("unknown event type %q of event %d", r.Type, r.Sequence)
+	}
+
+	return withSequence(e, r.Sequence), nil
+}
+
+func withSequence(e event.EventInfo, sequence int64) event.EventInfo {
+	switch evt := e.(type) {
+	case event.PomodoroEvent:
+		evt.Sequence = sequence
+		return evt
+	case event.TaskEvent:
+		evt.Sequence = sequence
+		return evt
+	default:
+		return e
+	}
+}
@@ -0,0 +1,132 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/clock"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+)
+
+func TestEventLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := memory.NewMemoryStorage()
+	eventLog := core.NewEventLog(store, event.NewInMemoryBus())
+	svc := core.NewTaskService(store, eventLog, core.WithTaskClock(clock.NewFake(epoch)))
+
+	events, unsubscribe := eventLog.SubscribeChannel(event.AllEventTypes)
+	defer unsubscribe()
+
+	task, err := svc.CreateTask(ctx, "write docs", core.TaskDetails{Project: "gomodoro"})
+	if err != nil {
+		t.Fatalf("CreateTask() returned error: %v", err)
+	}
+
+	if got := waitForTaskEvent(t, events); got.Sequence != 1 {
+		t.Errorf("live %s event has sequence %d, want 1", got.Type, got.Sequence)
+	}
+
+	title := "write more docs"
+	if _, err := svc.UpdateTask(ctx, task.ID, core.TaskUpdate{Title: &title}); err != nil {
+		t.Fatalf("UpdateTask() returned error: %v", err)
+	}
+
+	if got := waitForTaskEvent(t, events); got.Sequence != 2 {
+		t.Errorf("live %s event has sequence %d, want 2", got.Type, got.Sequence)
+	}
+
+	// Ticks are delivered live but not recorded.
+	eventLog.Publish(event.PomodoroEvent{BaseEvent: event.BaseEvent{Type: event.PomodoroTick, Timestamp: epoch}})
+
+	select {
+	case e := <-events:
+		if pe, ok := e.(event.PomodoroEvent); !ok || pe.Sequence != 0 {
+			t.Errorf("live tick event = %+v, want a pomodoro event without sequence", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for tick event")
+	}
+
+	replayed, err := eventLog.EventsSince(0, event.AllEventTypes)
+	if err != nil {
+		t.Fatalf("EventsSince() returned error: %v", err)
+	}
+
+	if len(replayed) != 2 {
+		t.Fatalf("EventsSince(0) returned %d events, want 2", len(replayed))
+	}
+
+	created, ok := replayed[0].(event.TaskEvent)
+	if !ok {
+		t.Fatalf("EventsSince(0)[0] = %T, want event.TaskEvent", replayed[0])
+	}
+
+	if created.Type != event.TaskCreated || created.Sequence != 1 || created.Title != "write docs" ||
+		created.Project != "gomodoro" || !created.Timestamp.Equal(epoch) {
+		t.Errorf("EventsSince(0)[0] = %+v, want the created task with sequence 1", created)
+	}
+
+	replayed, err = eventLog.EventsSince(1, event.AllEventTypes)
+	if err != nil {
+		t.Fatalf("EventsSince() returned error: %v", err)
+	}
+
+	if len(replayed) != 1 || replayed[0].GetEventType() != event.TaskUpdated || replayed[0].GetSequence() != 2 {
+		t.Errorf("EventsSince(1) = %+v, want the update with sequence 2", replayed)
+	}
+
+	replayed, err = eventLog.EventsSince(0, []event.EventType{event.PomodoroStarted})
+	if err != nil {
+		t.Fatalf("EventsSince() returned error: %v", err)
+	}
+
+	if len(replayed) != 0 {
+		t.Errorf("EventsSince(0) of pomodoro events = %+v, want none", replayed)
+	}
+}
+
+// compactedEvents is an event storage whose oldest events were dropped, so that it starts at sequence 3.
+type compactedEvents struct{}
+
+func (compactedEvents) AppendEvent(*storage.Event) error {
+	return nil
+}
+
+func (compactedEvents) GetEventsSince(sequence int64) ([]*storage.Event, error) {
+	events := make([]*storage.Event, 0)
+	for s := max(sequence+1, 3); s <= 4; s++ {
+		events = append(events, &storage.Event{Sequence: s, Type: string(event.TaskCreated), Payload: []byte(`{}`)})
+	}
+
+	return events, nil
+}
+
+func TestEventLogDroppedEvents(t *testing.T) {
+	t.Parallel()
+
+	eventLog := core.NewEventLog(compactedEvents{}, event.NewInMemoryBus())
+
+	for _, sequence := range []int64{0, 1} {
+		if _, err := eventLog.EventsSince(sequence, event.AllEventTypes); !errors.Is(err, core.ErrEventsDropped) {
+			t.Errorf("EventsSince(%d) returned %v, want ErrEventsDropped", sequence, err)
+		}
+	}
+
+	for sequence, want := range map[int64]int{2: 2, 3: 1, 4: 0} {
+		replayed, err := eventLog.EventsSince(sequence, event.AllEventTypes)
+		if err != nil {
+			t.Fatalf("EventsSince(%d) returned error: %v", sequence, err)
+		}
+
+		if len(replayed) != want {
+			t.Errorf("EventsSince(%d) returned %d events, want %d", sequence, len(replayed), want)
+		}
+	}
+}
@@ -4,46 +4,142 @@ package core
 import (
 	"context"
 	"fmt"
+	"math"
 	"slices"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/hatappi/go-kit/log"
 
+	"github.com/hatappi/gomodoro/internal/clock"
+	"github.com/hatappi/gomodoro/internal/config"
 	"github.com/hatappi/gomodoro/internal/core/event"
 	"github.com/hatappi/gomodoro/internal/storage"
 )
 
 // Pomodoro represents a pomodoro session with its current state.
 type Pomodoro struct {
-	ID            string              `json:"id"`
-	State         event.PomodoroState `json:"state"`
-	StartTime     time.Time           `json:"start_time"`
-	WorkDuration  time.Duration       `json:"work_duration"`
-	BreakDuration time.Duration       `json:"break_duration"`
-	RemainingTime time.Duration       `json:"remaining_time"`
-	ElapsedTime   time.Duration       `json:"elapsed_time"`
-	Phase         event.PomodoroPhase `json:"phase"`
-	PhaseCount    int                 `json:"phase_count"`
-	PhaseDuration time.Duration       `json:"phase_duration"`
-	TaskID        string              `json:"task_id,omitempty"`
+	ID             string              `json:"id"`
+	State          event.PomodoroState `json:"state"`
+	StartTime      time.Time           `json:"start_time"`
+	EndTime        time.Time           `json:"end_time"`
+	WorkDuration   time.Duration       `json:"work_duration"`
+	BreakDuration  time.Duration       `json:"break_duration"`
+	RemainingTime  time.Duration       `json:"remaining_time"`
+	ElapsedTime    time.Duration       `json:"elapsed_time"`
+	Phase          event.PomodoroPhase `json:"phase"`
+	PhaseCount     int                 `json:"phase_count"`
+	PhaseDuration  time.Duration       `json:"phase_duration"`
+	BreakFrequency int                 `json:"break_frequency"`
+	TaskID         string              `json:"task_id,omitempty"`
+	AutoAdvance    bool                `json:"auto_advance,omitempty"`
 }
 
+// Interrupted reports whether a finished session was stopped before its phase ended.
+// Stopped sessions are finished without remaining time, so the elapsed time is what tells them apart.
+func (p *Pomodoro) Interrupted() bool {
+	return p.ElapsedTime < p.PhaseDuration
+}
+
+// CyclePosition returns the 1-based number of the work session a phase belongs to
+// within the current long break cycle, so the first work session and the break after it are both 1.
+func CyclePosition(phaseCount, breakFrequency int) int {
+	if phaseCount <= 0 {
+		return 0
+	}
+
+	if breakFrequency <= 0 {
+		breakFrequency = config.DefaultBreakFrequency
+	}
+
+	workSession := (phaseCount + 1) / 2 //nolint:mnd
+
+	return (workSession-1)%breakFrequency + 1
+}
+
+// tickInterval is how often a running timer publishes its progress.
+const tickInterval = time.Second
+
 // PomodoroService provides operations for managing pomodoro sessions.
 type PomodoroService struct {
 	storage  storage.PomodoroStorage
 	eventBus event.EventBus
-	ticker   *time.Ticker
-	stopChan chan struct{}
+	clock    clock.Clock
+
+	// mu guards timer and serializes timer ticks with the operations that stop the timer.
+	mu    sync.Mutex
+	timer *phaseTimer
+}
+
+// phaseTimer tracks the deadline of the phase whose timer is running.
+type phaseTimer struct {
+	id            string
+	deadline      time.Time
+	phaseDuration time.Duration
+	stop          chan struct{}
+}
+
+// progress returns the remaining and elapsed seconds of the phase at now.
+func (t *phaseTimer) progress(now time.Time) (int, int) {
+	remainingSecs := max(int(math.Round(t.deadline.Sub(now).Seconds())), 0)
+
+	return remainingSecs, int(t.phaseDuration.Seconds()) - remainingSecs
+}
+
+// PomodoroServiceOption configures a PomodoroService.
+type PomodoroServiceOption func(*PomodoroService)
+
+// WithClock sets the clock the service measures time with.
+func WithClock(c clock.Clock) PomodoroServiceOption {
+	return func(s *PomodoroService) {
+		s.clock = c
+	}
 }
 
 // NewPomodoroService creates a new pomodoro service instance.
-func NewPomodoroService(storage storage.PomodoroStorage, eventBus event.EventBus) *PomodoroService {
-	return &PomodoroService{
+func NewPomodoroService(
+	storage storage.PomodoroStorage,
+	eventBus event.EventBus,
+	opts ...PomodoroServiceOption,
+) *PomodoroService {
+	s := &PomodoroService{
 		storage:  storage,
 		eventBus: eventBus,
-		stopChan: make(chan struct{}),
+		clock:    clock.New(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// StartOption configures a session started by PomodoroService.Start.
+type StartOption func(*storage.Pomodoro)
+
+// WithAutoAdvance makes the session start its following phase as soon as it completes,
+// so the phases keep cycling without a client that starts each one.
+func WithAutoAdvance() StartOption {
+	return func(p *storage.Pomodoro) {
+		p.AutoAdvance = true
+	}
+}
+
+// WithSkipBreak starts the work session that follows a break when the session would be a break,
+// e.g. for a "Skip" button shown when a work session completes.
+func WithSkipBreak() StartOption {
+	return func(p *storage.Pomodoro) {
+		if p.Phase == storage.PomodoroPhaseWork {
+			return
+		}
+
+		p.Phase = storage.PomodoroPhaseWork
+		p.PhaseCount++
+		p.PhaseDuration = p.WorkDuration
+		p.RemainingTime = p.WorkDuration
 	}
 }
 
@@ -53,7 +149,9 @@ func (s *PomodoroService) Start(
 	workDuration,
 	breakDuration time.Duration,
 	longBreakDuration time.Duration,
+	breakFrequency int,
 	taskID string,
+	opts ...StartOption,
 ) (*Pomodoro, error) {
 	latestPomodoro, err := s.LatestPomodoro()
 	if err != nil {
@@ -64,11 +162,30 @@ func (s *PomodoroService) Start(
 		return nil, fmt.Errorf("active pomodoro session already exists")
 	}
 
+	return s.start(ctx, latestPomodoro, workDuration, breakDuration, longBreakDuration, breakFrequency, taskID, opts...)
+}
+
+// start begins the session following latestPomodoro, or the first work session if it is nil.
+func (s *PomodoroService) start(
+	ctx context.Context,
+	latestPomodoro *Pomodoro,
+	workDuration,
+	breakDuration time.Duration,
+	longBreakDuration time.Duration,
+	breakFrequency int,
+	taskID string,
+	opts ...StartOption,
+) (*Pomodoro, error) {
+	if breakFrequency <= 0 {
+		breakFrequency = config.DefaultBreakFrequency
+	}
+
 	phase, duration, phaseCount := s.determinePhaseAndDuration(
 		latestPomodoro,
 		workDuration,
 		breakDuration,
 		longBreakDuration,
+		breakFrequency,
 	)
 
 	var phaseDuration time.Duration
@@ -81,10 +198,13 @@ func (s *PomodoroService) Start(
 		phaseDuration = workDuration
 	}
 
+	now := s.clock.Now()
+
 	pomodoro := &storage.Pomodoro{
 		ID:                uuid.New().String(),
 		State:             storage.PomodoroStateActive,
-		StartTime:         time.Now(),
+		StartTime:         now,
+		UpdatedAt:         now,
 		WorkDuration:      workDuration,
 		BreakDuration:     breakDuration,
 		LongBreakDuration: longBreakDuration,
@@ -92,23 +212,28 @@ func (s *PomodoroService) Start(
 		Phase:             phase,
 		PhaseDuration:     phaseDuration,
 		PhaseCount:        phaseCount,
+		BreakFrequency:    breakFrequency,
 		TaskID:            taskID,
 	}
 
+	for _, opt := range opts {
+		opt(pomodoro)
+	}
+
 	if err := s.storage.SavePomodoro(pomodoro); err != nil {
 		return nil, fmt.Errorf("failed to save pomodoro: %w", err)
 	}
 
 	s.publishPomodoroEvent(event.PomodoroStarted, pomodoro)
 
-	s.startTimer(ctx, pomodoro.ID, duration)
+	s.startTimer(ctx, pomodoro.ID, pomodoro.RemainingTime, pomodoro.PhaseDuration)
 
 	return s.storagePomodoroToCore(pomodoro), nil
 }
 
 // Pause pauses an active pomodoro session.
 func (s *PomodoroService) Pause(_ context.Context, id string) (*Pomodoro, error) {
-	s.stopTimer()
+	timer := s.stopTimer()
 
 	activePomodoro, err := s.storage.GetActivePomodoro()
 	if err != nil {
@@ -119,11 +244,14 @@ func (s *PomodoroService) Pause(_ context.Context, id string) (*Pomodoro, error)
 		return nil, fmt.Errorf("no active pomodoro found")
 	}
 
+	remainingSecs, elapsedSecs := s.progress(timer, activePomodoro)
+
 	pomodoro, err := s.storage.UpdatePomodoroState(
 		id,
 		storage.PomodoroStatePaused,
-		int(activePomodoro.RemainingTime.Seconds()),
-		int(activePomodoro.ElapsedTime.Seconds()),
+		remainingSecs,
+		elapsedSecs,
+		s.clock.Now(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update pomodoro state: %w", err)
@@ -154,6 +282,7 @@ func (s *PomodoroService) Resume(ctx context.Context, id string) (*Pomodoro, err
 		storage.PomodoroStateActive,
 		int(activePomodoro.RemainingTime.Seconds()),
 		int(activePomodoro.ElapsedTime.Seconds()),
+		s.clock.Now(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update pomodoro state: %w", err)
@@ -161,14 +290,14 @@ func (s *PomodoroService) Resume(ctx context.Context, id string) (*Pomodoro, err
 
 	s.publishPomodoroEvent(event.PomodoroResumed, pomodoro)
 
-	s.startTimer(ctx, id, pomodoro.RemainingTime)
+	s.startTimer(ctx, id, pomodoro.RemainingTime, pomodoro.PhaseDuration)
 
 	return s.storagePomodoroToCore(pomodoro), nil
 }
 
 // Stop stops the current pomodoro session.
 func (s *PomodoroService) Stop(_ context.Context, id string) error {
-	s.stopTimer()
+	timer := s.stopTimer()
 
 	activePomodoro, err := s.storage.GetActivePomodoro()
 	if err != nil {
@@ -179,16 +308,23 @@ func (s *PomodoroService) Stop(_ context.Context, id string) error {
 		return fmt.Errorf("no active pomodoro found")
 	}
 
+	_, elapsedSecs := s.progress(timer, activePomodoro)
+
 	pomodoro, err := s.storage.UpdatePomodoroState(
 		id,
 		storage.PomodoroStateFinished,
 		0,
-		int(activePomodoro.ElapsedTime.Seconds()),
+		elapsedSecs,
+		s.clock.Now(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update pomodoro state: %w", err)
 	}
 
+	if err := s.recordHistory(pomodoro, s.clock.Now()); err != nil {
+		return err
+	}
+
 	s.publishPomodoroEvent(event.PomodoroStopped, pomodoro)
 
 	return nil
@@ -212,11 +348,19 @@ func (s *PomodoroService) Reset(_ context.Context) (*Pomodoro, error) {
 		storage.PomodoroStateFinished,
 		0,
 		int(latestPomodoro.ElapsedTime.Seconds()),
+		s.clock.Now(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update pomodoro state: %w", err)
 	}
 
+	// A finished session has already been recorded when it completed or was stopped.
+	if latestPomodoro.State != storage.PomodoroStateFinished {
+		if err := s.recordHistory(pomodoro, s.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.storage.DeletePomodoro(latestPomodoro.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete pomodoro: %w", err)
@@ -266,69 +410,307 @@ func (s *PomodoroService) LatestPomodoro() (*Pomodoro, error) {
 	return s.storagePomodoroToCore(pomodoro), nil
 }
 
-// startTimer starts the timer for a pomodoro session.
-func (s *PomodoroService) startTimer(ctx context.Context, id string, duration time.Duration) {
+// History retrieves the sessions started within [start, end).
+// A zero start or end leaves that side of the range open.
+func (s *PomodoroService) History(start, end time.Time) ([]*Pomodoro, error) {
+	pomodoros, err := s.storage.GetPomodoroHistory(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	return s.storagePomodorosToCore(pomodoros), nil
+}
+
+// TaskHistory retrieves the sessions recorded for a task.
+func (s *PomodoroService) TaskHistory(taskID string) ([]*Pomodoro, error) {
+	pomodoros, err := s.storage.GetPomodoroHistoryByTaskID(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	return s.storagePomodorosToCore(pomodoros), nil
+}
+
+// PomodoroPage is a page of the pomodoro history selected by a storage.PomodoroQuery.
+type PomodoroPage struct {
+	Pomodoros       []*Pomodoro
+	TotalCount      int
+	HasPreviousPage bool
+	HasNextPage     bool
+}
+
+// QueryHistory retrieves the page of the history selected by the query.
+func (s *PomodoroService) QueryHistory(query storage.PomodoroQuery) (*PomodoroPage, error) {
+	page, err := s.storage.QueryPomodoroHistory(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pomodoro history: %w", err)
+	}
+
+	return &PomodoroPage{
+		Pomodoros:       s.storagePomodorosToCore(page.Pomodoros),
+		TotalCount:      page.TotalCount,
+		HasPreviousPage: page.HasPreviousPage,
+		HasNextPage:     page.HasNextPage,
+	}, nil
+}
+
+// GetPomodoroByID retrieves a session by its ID.
+// The current session is looked up first so that a running session reports its latest progress.
+func (s *PomodoroService) GetPomodoroByID(id string) (*Pomodoro, error) {
+	latest, err := s.storage.GetLatestPomodoro()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest pomodoro: %w", err)
+	}
+
+	if latest != nil && latest.ID == id {
+		return s.storagePomodoroToCore(latest), nil
+	}
+
+	pomodoro, err := s.storage.GetPomodoroHistoryByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro: %w", err)
+	}
+
+	return s.storagePomodoroToCore(pomodoro), nil
+}
+
+// Recover restores the session that was active or paused when the server last stopped.
+// An active session is credited with the time that passed since it was last updated:
+// it keeps running if time is left, and is finished and reported as completed otherwise.
+// It returns nil when there is no session to recover.
+func (s *PomodoroService) Recover(ctx context.Context) (*Pomodoro, error) {
+	activePomodoro, err := s.storage.GetActivePomodoro()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active pomodoro: %w", err)
+	}
+
+	if activePomodoro == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	// A paused session does not progress while the server is down.
+	if activePomodoro.State == storage.PomodoroStatePaused {
+		return s.storagePomodoroToCore(activePomodoro), nil
+	}
+
+	now := s.clock.Now()
+
+	elapsed := now.Sub(activePomodoro.StartTime)
+	if !activePomodoro.UpdatedAt.IsZero() {
+		elapsed = activePomodoro.ElapsedTime + now.Sub(activePomodoro.UpdatedAt)
+	}
+
+	if elapsed >= activePomodoro.PhaseDuration {
+		pomodoro, err := s.storage.UpdatePomodoroState(
+			activePomodoro.ID,
+			storage.PomodoroStateFinished,
+			0,
+			int(activePomodoro.PhaseDuration.Seconds()),
+			now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update pomodoro state: %w", err)
+		}
+
+		endTime := now.Add(activePomodoro.PhaseDuration - elapsed)
+		if err := s.recordHistory(pomodoro, endTime); err != nil {
+			return nil, err
+		}
+
+		s.publishPomodoroEvent(event.PomodoroCompleted, pomodoro)
+
+		// Phases that would have run while the server was down are not made up for;
+		// the cycle continues from now.
+		if pomodoro.AutoAdvance {
+			return s.advance(ctx, pomodoro)
+		}
+
+		return s.storagePomodoroToCore(pomodoro), nil
+	}
+
+	remaining := activePomodoro.PhaseDuration - elapsed
+
+	pomodoro, err := s.storage.UpdatePomodoroState(
+		activePomodoro.ID,
+		storage.PomodoroStateActive,
+		int(remaining.Seconds()),
+		int(elapsed.Seconds()),
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pomodoro state: %w", err)
+	}
+
+	log.FromContext(ctx).V(1).Info("Recovered active pomodoro", "id", pomodoro.ID, "remainingSec", remaining.Seconds())
+
+	s.publishPomodoroEvent(event.PomodoroResumed, pomodoro)
+
+	s.startTimer(ctx, pomodoro.ID, remaining, pomodoro.PhaseDuration)
+
+	return s.storagePomodoroToCore(pomodoro), nil
+}
+
+// startTimer starts the timer for a pomodoro session with the given remaining time.
+// The remaining time is always derived from the phase deadline, so a delayed or missed tick
+// does not make the timer drift; ticks only publish the progress.
+func (s *PomodoroService) startTimer(ctx context.Context, id string, remaining, phaseDuration time.Duration) {
 	s.stopTimer()
 
-	s.ticker = time.NewTicker(1 * time.Second)
+	timer := &phaseTimer{
+		id:            id,
+		deadline:      s.clock.Now().Add(remaining),
+		phaseDuration: phaseDuration,
+		stop:          make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	s.timer = timer
+	s.mu.Unlock()
 
-	originalDuration := duration
+	ticker := s.clock.NewTicker(tickInterval)
 
 	go func() {
-		remainingSecs := int(duration.Seconds())
+		defer ticker.Stop()
 
 		for {
 			select {
-			case <-s.ticker.C:
-				remainingSecs--
-
-				pomodoro, err := s.storage.UpdatePomodoroState(
-					id,
-					storage.PomodoroStateActive,
-					remainingSecs,
-					int(originalDuration.Seconds())-remainingSecs,
-				)
-				if err != nil {
-					log.FromContext(ctx).Error(err, "Failed to update pomodoro time")
+			case <-ticker.C():
+				finished, done := s.tick(ctx, timer)
+				if !done {
+					continue
 				}
 
-				s.publishPomodoroEvent(event.PomodoroTick, pomodoro)
-
-				if remainingSecs <= 0 {
-					pomodoro, err := s.storage.UpdatePomodoroState(
-						id,
-						storage.PomodoroStateFinished,
-						0,
-						int(originalDuration.Seconds()),
-					)
-					if err != nil {
-						log.FromContext(ctx).Error(err, "Failed to update pomodoro state")
+				if finished != nil && finished.AutoAdvance {
+					if _, err := s.advance(ctx, finished); err != nil {
+						log.FromContext(ctx).Error(err, "Failed to start the next phase")
 					}
-
-					s.publishPomodoroEvent(event.PomodoroCompleted, pomodoro)
-
-					s.stopTimer()
-					return
 				}
 
-			case <-s.stopChan:
+				return
+			case <-timer.stop:
 				return
 			}
 		}
 	}()
 }
 
-// stopTimer stops any running timer.
-func (s *PomodoroService) stopTimer() {
-	if s.ticker != nil {
-		s.ticker.Stop()
-		s.ticker = nil
+// tick publishes the progress of the running phase and finishes it once the deadline has passed.
+// It reports whether the timer is done and returns the session if this tick finished it.
+func (s *PomodoroService) tick(ctx context.Context, timer *phaseTimer) (*storage.Pomodoro, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-		select {
-		case s.stopChan <- struct{}{}:
-		default:
-		}
+	// The timer may have been stopped while this tick was waiting for the lock.
+	if s.timer != timer {
+		return nil, true
+	}
+
+	remainingSecs, elapsedSecs := timer.progress(s.clock.Now())
+
+	pomodoro, err := s.storage.UpdatePomodoroState(
+		timer.id,
+		storage.PomodoroStateActive,
+		remainingSecs,
+		elapsedSecs,
+		s.clock.Now(),
+	)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update pomodoro time")
+	}
+
+	s.publishPomodoroEvent(event.PomodoroTick, pomodoro)
+
+	if remainingSecs > 0 {
+		return nil, false
 	}
+
+	s.timer = nil
+
+	pomodoro, err = s.storage.UpdatePomodoroState(
+		timer.id,
+		storage.PomodoroStateFinished,
+		0,
+		int(timer.phaseDuration.Seconds()),
+		s.clock.Now(),
+	)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update pomodoro state")
+	}
+
+	if err := s.recordHistory(pomodoro, timer.deadline); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to record pomodoro history")
+	}
+
+	s.publishPomodoroEvent(event.PomodoroCompleted, pomodoro)
+
+	return pomodoro, true
+}
+
+// advance starts the phase following a finished session with the same settings.
+// It does nothing if the session is no longer the latest one, e.g. because it was reset in the meantime.
+func (s *PomodoroService) advance(ctx context.Context, finished *storage.Pomodoro) (*Pomodoro, error) {
+	latestPomodoro, err := s.LatestPomodoro()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest pomodoro: %w", err)
+	}
+
+	if latestPomodoro == nil || latestPomodoro.ID != finished.ID || latestPomodoro.State != event.PomodoroStateFinished {
+		return latestPomodoro, nil
+	}
+
+	return s.start(
+		ctx,
+		latestPomodoro,
+		finished.WorkDuration,
+		finished.BreakDuration,
+		finished.LongBreakDuration,
+		finished.BreakFrequency,
+		finished.TaskID,
+		WithAutoAdvance(),
+	)
+}
+
+// stopTimer stops any running timer and returns it, or nil if no timer was running.
+// It waits for an in-flight tick, so no tick updates the session after it returns.
+func (s *PomodoroService) stopTimer() *phaseTimer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	timer := s.timer
+	if timer == nil {
+		return nil
+	}
+
+	close(timer.stop)
+	s.timer = nil
+
+	return timer
+}
+
+// progress returns the remaining and elapsed seconds of a session,
+// measured against the deadline of its timer if the timer was running.
+func (s *PomodoroService) progress(timer *phaseTimer, p *storage.Pomodoro) (int, int) {
+	if timer != nil && timer.id == p.ID && p.State == storage.PomodoroStateActive {
+		return timer.progress(s.clock.Now())
+	}
+
+	return int(p.RemainingTime.Seconds()), int(p.ElapsedTime.Seconds())
+}
+
+// recordHistory stamps the end time of a finished session and appends it to the history.
+func (s *PomodoroService) recordHistory(p *storage.Pomodoro, endTime time.Time) error {
+	if p == nil {
+		return nil
+	}
+
+	p.EndTime = endTime
+
+	if err := s.storage.AddPomodoroHistory(p); err != nil {
+		return fmt.Errorf("failed to add pomodoro history: %w", err)
+	}
+
+	return nil
 }
 
 // publishPomodoroEvent publishes a pomodoro event to the event bus.
@@ -336,16 +718,17 @@ func (s *PomodoroService) publishPomodoroEvent(eventType event.EventType, p *sto
 	e := event.PomodoroEvent{
 		BaseEvent: event.BaseEvent{
 			Type:      eventType,
-			Timestamp: time.Now(),
+			Timestamp: s.clock.Now(),
 		},
-		ID:            p.ID,
-		State:         event.PomodoroState(p.State),
-		RemainingTime: p.RemainingTime,
-		ElapsedTime:   p.ElapsedTime,
-		TaskID:        p.TaskID,
-		Phase:         event.PomodoroPhase(p.Phase),
-		PhaseCount:    p.PhaseCount,
-		PhaseDuration: p.PhaseDuration,
+		ID:             p.ID,
+		State:          event.PomodoroState(p.State),
+		RemainingTime:  p.RemainingTime,
+		ElapsedTime:    p.ElapsedTime,
+		TaskID:         p.TaskID,
+		Phase:          event.PomodoroPhase(p.Phase),
+		PhaseCount:     p.PhaseCount,
+		PhaseDuration:  p.PhaseDuration,
+		BreakFrequency: p.BreakFrequency,
 	}
 
 	s.eventBus.Publish(e)
@@ -358,24 +741,38 @@ func (s *PomodoroService) storagePomodoroToCore(p *storage.Pomodoro) *Pomodoro {
 	}
 
 	return &Pomodoro{
-		ID:            p.ID,
-		State:         event.PomodoroState(p.State),
-		StartTime:     p.StartTime,
-		WorkDuration:  p.WorkDuration,
-		BreakDuration: p.BreakDuration,
-		RemainingTime: p.RemainingTime,
-		ElapsedTime:   p.ElapsedTime,
-		Phase:         event.PomodoroPhase(p.Phase),
-		PhaseDuration: p.PhaseDuration,
-		PhaseCount:    p.PhaseCount,
-		TaskID:        p.TaskID,
+		ID:             p.ID,
+		State:          event.PomodoroState(p.State),
+		StartTime:      p.StartTime,
+		EndTime:        p.EndTime,
+		WorkDuration:   p.WorkDuration,
+		BreakDuration:  p.BreakDuration,
+		RemainingTime:  p.RemainingTime,
+		ElapsedTime:    p.ElapsedTime,
+		Phase:          event.PomodoroPhase(p.Phase),
+		PhaseDuration:  p.PhaseDuration,
+		PhaseCount:     p.PhaseCount,
+		BreakFrequency: p.BreakFrequency,
+		TaskID:         p.TaskID,
+		AutoAdvance:    p.AutoAdvance,
 	}
 }
 
+// storagePomodorosToCore converts a slice of storage.Pomodoro to core.Pomodoro.
+func (s *PomodoroService) storagePomodorosToCore(pomodoros []*storage.Pomodoro) []*Pomodoro {
+	result := make([]*Pomodoro, len(pomodoros))
+	for i, p := range pomodoros {
+		result[i] = s.storagePomodoroToCore(p)
+	}
+
+	return result
+}
+
 func (s *PomodoroService) determinePhaseAndDuration(
 	latestPomodoro *Pomodoro,
 	workDuration, breakDuration,
 	longBreakDuration time.Duration,
+	breakFrequency int,
 ) (storage.PomodoroPhase, time.Duration, int) {
 	if latestPomodoro == nil {
 		return storage.PomodoroPhaseWork, workDuration, 1
@@ -388,7 +785,8 @@ func (s *PomodoroService) determinePhaseAndDuration(
 		return storage.PomodoroPhaseWork, workDuration, phaseCount
 	}
 
-	if time.Duration(phaseCount)%6 == 0 {
+	// Work sessions take the odd phase counts, so the break after the N-th work session is phase 2N.
+	if phaseCount%(2*breakFrequency) == 0 {
 		return storage.PomodoroPhaseLongBreak, longBreakDuration, phaseCount
 	}
 
@@ -0,0 +1,636 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/clock"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+)
+
+const (
+	workDuration      = 25 * time.Minute
+	breakDuration     = 5 * time.Minute
+	longBreakDuration = 15 * time.Minute
+	breakFrequency    = 2
+)
+
+var epoch = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+type pomodoroFixture struct {
+	svc     *core.PomodoroService
+	clock   *clock.Fake
+	bus     *event.InMemoryBus
+	storage *memory.MemoryStorage
+}
+
+func newPomodoroFixture(t *testing.T) *pomodoroFixture {
+	t.Helper()
+
+	f := &pomodoroFixture{
+		clock:   clock.NewFake(epoch),
+		bus:     event.NewInMemoryBus(),
+		storage: memory.NewMemoryStorage(),
+	}
+	f.svc = core.NewPomodoroService(f.storage, f.bus, core.WithClock(f.clock))
+
+	return f
+}
+
+// subscribe returns a channel receiving the events of the given types.
+// The events arrive in the order they were published, as each subscription has its own queue.
+func (f *pomodoroFixture) subscribe(t *testing.T, eventTypes ...event.EventType) <-chan interface{} {
+	t.Helper()
+
+	ch, unsubscribe := f.bus.SubscribeChannel(eventTypes)
+	t.Cleanup(unsubscribe)
+
+	return ch
+}
+
+func (f *pomodoroFixture) start(t *testing.T) *core.Pomodoro {
+	t.Helper()
+
+	p, err := f.svc.Start(context.Background(), workDuration, breakDuration, longBreakDuration, breakFrequency, "")
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	return p
+}
+
+func waitForEvent(t *testing.T, ch <-chan interface{}) event.PomodoroEvent {
+	t.Helper()
+
+	select {
+	case e := <-ch:
+		pe, ok := e.(event.PomodoroEvent)
+		if !ok {
+			t.Fatalf("unexpected event %T", e)
+		}
+
+		return pe
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	return event.PomodoroEvent{}
+}
+
+func assertEvent(t *testing.T, e event.PomodoroEvent, eventType event.EventType, id string) {
+	t.Helper()
+
+	if e.Type != eventType {
+		t.Errorf("event type = %s, want %s", e.Type, eventType)
+	}
+
+	if e.ID != id {
+		t.Errorf("event ID = %s, want %s", e.ID, id)
+	}
+}
+
+func assertHistoryLen(t *testing.T, svc *core.PomodoroService, want int) []*core.Pomodoro {
+	t.Helper()
+
+	history, err := svc.History(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+
+	if len(history) != want {
+		t.Fatalf("len(History()) = %d, want %d", len(history), want)
+	}
+
+	return history
+}
+
+func TestPomodoroServiceStart(t *testing.T) {
+	t.Parallel()
+
+	f := newPomodoroFixture(t)
+	started := f.subscribe(t, event.PomodoroStarted)
+
+	p := f.start(t)
+
+	if p.State != event.PomodoroStateActive {
+		t.Errorf("State = %s, want %s", p.State, event.PomodoroStateActive)
+	}
+
+	if p.Phase != event.PomodoroPhaseWork || p.PhaseCount != 1 {
+		t.Errorf("phase = %s #%d, want %s #1", p.Phase, p.PhaseCount, event.PomodoroPhaseWork)
+	}
+
+	if p.RemainingTime != workDuration {
+		t.Errorf("RemainingTime = %v, want %v", p.RemainingTime, workDuration)
+	}
+
+	if !p.StartTime.Equal(epoch) {
+		t.Errorf("StartTime = %v, want %v", p.StartTime, epoch)
+	}
+
+	assertEvent(t, waitForEvent(t, started), event.PomodoroStarted, p.ID)
+
+	if _, err := f.svc.Start(context.Background(), workDuration, breakDuration, longBreakDuration, breakFrequency, ""); err == nil {
+		t.Error("Start() with an active session succeeded, want error")
+	}
+}
+
+func TestPomodoroServiceTick(t *testing.T) {
+	t.Parallel()
+
+	f := newPomodoroFixture(t)
+	ticks := f.subscribe(t, event.PomodoroTick)
+
+	p := f.start(t)
+
+	f.clock.Advance(time.Second)
+
+	e := waitForEvent(t, ticks)
+	assertEvent(t, e, event.PomodoroTick, p.ID)
+
+	if e.RemainingTime != workDuration-time.Second {
+		t.Errorf("RemainingTime = %v, want %v", e.RemainingTime, workDuration-time.Second)
+	}
+
+	if e.ElapsedTime != time.Second {
+		t.Errorf("ElapsedTime = %v, want %v", e.ElapsedTime, time.Second)
+	}
+}
+
+func TestPomodoroServiceCompletesOnDeadline(t *testing.T) {
+	t.Parallel()
+
+	f := newPomodoroFixture(t)
+	completed := f.subscribe(t, event.PomodoroCompleted)
+
+	p := f.start(t)
+
+	f.clock.Advance(workDuration)
+
+	e := waitForEvent(t, completed)
+	assertEvent(t, e, event.PomodoroCompleted, p.ID)
+
+	if e.ElapsedTime != workDuration {
+		t.Errorf("ElapsedTime = %v, want %v", e.ElapsedTime, workDuration)
+	}
+
+	history := assertHistoryLen(t, f.svc, 1)
+	if want := epoch.Add(workDuration); !history[0].EndTime.Equal(want) {
+		t.Errorf("EndTime = %v, want %v", history[0].EndTime, want)
+	}
+
+	if history[0].State != event.PomodoroStateFinished {
+		t.Errorf("State = %s, want %s", history[0].State, event.PomodoroStateFinished)
+	}
+}
+
+func TestPomodoroServiceAutoAdvance(t *testing.T) {
+	t.Parallel()
+
+	f := newPomodoroFixture(t)
+	started := f.subscribe(t, event.PomodoroStarted)
+
+	p, err := f.svc.Start(
+		context.Background(),
+		workDuration,
+		breakDuration,
+		longBreakDuration,
+		breakFrequency,
+		"task",
+		core.WithAutoAdvance(),
+	)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	assertEvent(t, waitForEvent(t, started), event.PomodoroStarted, p.ID)
+
+	f.clock.Advance(workDuration)
+
+	e := waitForEvent(t, started)
+	if e.Phase != event.PomodoroPhaseShortBreak || e.PhaseCount != 2 {
+		t.Fatalf("next phase = %s #%d, want %s #2", e.Phase, e.PhaseCount, event.PomodoroPhaseShortBreak)
+	}
+
+	next, err := f.svc.ActivePomodoro()
+	if err != nil {
+		t.Fatalf("ActivePomodoro() error = %v", err)
+	}
+
+	if next.ID != e.ID || !next.AutoAdvance || next.TaskID != "task" {
+		t.Errorf("next pomodoro = %+v, want the auto-advancing break of task", next)
+	}
+
+	if !next.StartTime.Equal(epoch.Add(workDuration)) {
+		t.Errorf("StartTime = %v, want %v", next.StartTime, epoch.Add(workDuration))
+	}
+
+	assertHistoryLen(t, f.svc, 1)
+}
+
+func TestPomodoroServicePauseResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newPomodoroFixture(t)
+	events := f.subscribe(t, event.PomodoroPaused, event.PomodoroResumed, event.PomodoroCompleted)
+
+	p := f.start(t)
+
+	// Ticks may be dropped while the clock jumps; the remaining time must not depend on them.
+	f.clock.Advance(10 * time.Minute)
+
+	pausedPomodoro, err := f.svc.Pause(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+
+	if pausedPomodoro.State != event.PomodoroStatePaused {
+		t.Errorf("State = %s, want %s", pausedPomodoro.State, event.PomodoroStatePaused)
+	}
+
+	if pausedPomodoro.RemainingTime != 15*time.Minute || pausedPomodoro.ElapsedTime != 10*time.Minute {
+		t.Errorf("remaining/elapsed = %v/%v, want 15m/10m", pausedPomodoro.RemainingTime, pausedPomodoro.ElapsedTime)
+	}
+
+	assertEvent(t, waitForEvent(t, events), event.PomodoroPaused, p.ID)
+
+	// A paused session does not progress.
+	f.clock.Advance(time.Hour)
+
+	resumedPomodoro, err := f.svc.Resume(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+
+	if resumedPomodoro.State != event.PomodoroStateActive {
+		t.Errorf("State = %s, want %s", resumedPomodoro.State, event.PomodoroStateActive)
+	}
+
+	if resumedPomodoro.RemainingTime != 15*time.Minute {
+		t.Errorf("RemainingTime = %v, want %v", resumedPomodoro.RemainingTime, 15*time.Minute)
+	}
+
+	assertEvent(t, waitForEvent(t, events), event.PomodoroResumed, p.ID)
+
+	if _, err := f.svc.Resume(ctx, p.ID); err == nil {
+		t.Error("Resume() of an active session succeeded, want error")
+	}
+
+	f.clock.Advance(15 * time.Minute)
+
+	assertEvent(t, waitForEvent(t, events), event.PomodoroCompleted, p.ID)
+
+	history := assertHistoryLen(t, f.svc, 1)
+	if want := epoch.Add(time.Hour + workDuration); !history[0].EndTime.Equal(want) {
+		t.Errorf("EndTime = %v, want %v", history[0].EndTime, want)
+	}
+}
+
+func TestPomodoroServiceStop(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newPomodoroFixture(t)
+	stopped := f.subscribe(t, event.PomodoroStopped)
+
+	p := f.start(t)
+
+	f.clock.Advance(5 * time.Minute)
+
+	if err := f.svc.Stop(ctx, p.ID); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	e := waitForEvent(t, stopped)
+	assertEvent(t, e, event.PomodoroStopped, p.ID)
+
+	if e.ElapsedTime != 5*time.Minute {
+		t.Errorf("ElapsedTime = %v, want %v", e.ElapsedTime, 5*time.Minute)
+	}
+
+	if _, err := f.svc.ActivePomodoro(); err == nil {
+		t.Error("ActivePomodoro() after Stop() succeeded, want error")
+	}
+
+	if err := f.svc.Stop(ctx, p.ID); err == nil {
+		t.Error("Stop() without an active session succeeded, want error")
+	}
+
+	history := assertHistoryLen(t, f.svc, 1)
+	if want := epoch.Add(5 * time.Minute); !history[0].EndTime.Equal(want) {
+		t.Errorf("EndTime = %v, want %v", history[0].EndTime, want)
+	}
+
+	// A stopped work session is still followed by a break.
+	next := f.start(t)
+	if next.Phase != event.PomodoroPhaseShortBreak || next.PhaseCount != 2 {
+		t.Errorf("next phase = %s #%d, want %s #2", next.Phase, next.PhaseCount, event.PomodoroPhaseShortBreak)
+	}
+}
+
+func TestPomodoroServiceSkipBreak(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newPomodoroFixture(t)
+	completed := f.subscribe(t, event.PomodoroCompleted)
+
+	start := func() *core.Pomodoro {
+		p, err := f.svc.Start(ctx, workDuration, breakDuration, longBreakDuration, breakFrequency, "", core.WithSkipBreak())
+		if err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+
+		return p
+	}
+
+	// The first session is a work session anyway.
+	first := start()
+	if first.Phase != event.PomodoroPhaseWork || first.PhaseCount != 1 {
+		t.Fatalf("first phase = %s #%d, want %s #1", first.Phase, first.PhaseCount, event.PomodoroPhaseWork)
+	}
+
+	f.clock.Advance(workDuration)
+	waitForEvent(t, completed)
+
+	// The break after it is skipped, so the second work session takes phase 3.
+	second := start()
+	if second.Phase != event.PomodoroPhaseWork || second.PhaseCount != 3 {
+		t.Errorf("second phase = %s #%d, want %s #3", second.Phase, second.PhaseCount, event.PomodoroPhaseWork)
+	}
+
+	if second.RemainingTime != workDuration || second.PhaseDuration != workDuration {
+		t.Errorf("second RemainingTime = %v, PhaseDuration = %v, want %v",
+			second.RemainingTime, second.PhaseDuration, workDuration)
+	}
+
+	if got := core.CyclePosition(second.PhaseCount, second.BreakFrequency); got != 2 {
+		t.Errorf("CyclePosition() = %d, want 2", got)
+	}
+
+	f.clock.Advance(workDuration)
+
+	if e := waitForEvent(t, completed); e.ID != second.ID || e.ElapsedTime != workDuration {
+		t.Errorf("second session completed after %v, want %v", e.ElapsedTime, workDuration)
+	}
+
+	// Without the option, the long break after the second work session is not skipped.
+	if next := f.start(t); next.Phase != event.PomodoroPhaseLongBreak || next.PhaseCount != 4 {
+		t.Errorf("next phase = %s #%d, want %s #4", next.Phase, next.PhaseCount, event.PomodoroPhaseLongBreak)
+	}
+}
+
+func TestPomodoroServiceGetPomodoroByID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newPomodoroFixture(t)
+	stopped := f.subscribe(t, event.PomodoroStopped)
+
+	p := f.start(t)
+
+	current, err := f.svc.GetPomodoroByID(p.ID)
+	if err != nil {
+		t.Fatalf("GetPomodoroByID() error = %v", err)
+	}
+	if current.State != event.PomodoroStateActive {
+		t.Errorf("State = %s, want %s", current.State, event.PomodoroStateActive)
+	}
+
+	f.clock.Advance(5 * time.Minute)
+
+	if err := f.svc.Stop(ctx, p.ID); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	waitForEvent(t, stopped)
+
+	// Once the next session starts, the stopped one is only found in the history.
+	next := f.start(t)
+
+	past, err := f.svc.GetPomodoroByID(p.ID)
+	if err != nil {
+		t.Fatalf("GetPomodoroByID() error = %v", err)
+	}
+	if want := epoch.Add(5 * time.Minute); !past.EndTime.Equal(want) {
+		t.Errorf("EndTime = %v, want %v", past.EndTime, want)
+	}
+
+	if _, err := f.svc.GetPomodoroByID("missing"); err == nil {
+		t.Error("GetPomodoroByID() with a missing ID succeeded, want error")
+	}
+
+	page, err := f.svc.QueryHistory(storage.PomodoroQuery{TaskID: next.TaskID})
+	if err != nil {
+		t.Fatalf("QueryHistory() error = %v", err)
+	}
+	if page.TotalCount != 1 || page.Pomodoros[0].ID != p.ID {
+		t.Errorf("QueryHistory() = %d sessions, want only %s", page.TotalCount, p.ID)
+	}
+}
+
+func TestPomodoroServiceReset(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newPomodoroFixture(t)
+	reset := f.subscribe(t, event.PomodoroReset)
+
+	p := f.start(t)
+
+	f.clock.Advance(3 * time.Minute)
+
+	resetPomodoro, err := f.svc.Reset(ctx)
+	if err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+
+	if resetPomodoro.State != event.PomodoroStateFinished {
+		t.Errorf("State = %s, want %s", resetPomodoro.State, event.PomodoroStateFinished)
+	}
+
+	assertEvent(t, waitForEvent(t, reset), event.PomodoroReset, p.ID)
+
+	latest, err := f.svc.LatestPomodoro()
+	if err != nil {
+		t.Fatalf("LatestPomodoro() error = %v", err)
+	}
+
+	if latest != nil {
+		t.Errorf("LatestPomodoro() after Reset() = %+v, want nil", latest)
+	}
+
+	assertHistoryLen(t, f.svc, 1)
+
+	if _, err := f.svc.Reset(ctx); err == nil {
+		t.Error("Reset() without a session succeeded, want error")
+	}
+
+	next := f.start(t)
+	if next.Phase != event.PomodoroPhaseWork || next.PhaseCount != 1 {
+		t.Errorf("next phase = %s #%d, want %s #1", next.Phase, next.PhaseCount, event.PomodoroPhaseWork)
+	}
+}
+
+func TestPomodoroServiceLongBreakCycle(t *testing.T) {
+	t.Parallel()
+
+	f := newPomodoroFixture(t)
+	completed := f.subscribe(t, event.PomodoroCompleted)
+
+	tests := []struct {
+		phase    event.PomodoroPhase
+		duration time.Duration
+	}{
+		{event.PomodoroPhaseWork, workDuration},
+		{event.PomodoroPhaseShortBreak, breakDuration},
+		{event.PomodoroPhaseWork, workDuration},
+		{event.PomodoroPhaseLongBreak, longBreakDuration},
+		{event.PomodoroPhaseWork, workDuration},
+		{event.PomodoroPhaseShortBreak, breakDuration},
+		{event.PomodoroPhaseWork, workDuration},
+		{event.PomodoroPhaseLongBreak, longBreakDuration},
+	}
+
+	for i, tt := range tests {
+		p := f.start(t)
+
+		if p.Phase != tt.phase || p.PhaseCount != i+1 {
+			t.Fatalf("session %d: phase = %s #%d, want %s #%d", i+1, p.Phase, p.PhaseCount, tt.phase, i+1)
+		}
+
+		if p.PhaseDuration != tt.duration {
+			t.Errorf("session %d: PhaseDuration = %v, want %v", i+1, p.PhaseDuration, tt.duration)
+		}
+
+		wantPosition := i/2%breakFrequency + 1
+		if got := core.CyclePosition(p.PhaseCount, p.BreakFrequency); got != wantPosition {
+			t.Errorf("session %d: CyclePosition() = %d, want %d", i+1, got, wantPosition)
+		}
+
+		f.clock.Advance(tt.duration)
+
+		assertEvent(t, waitForEvent(t, completed), event.PomodoroCompleted, p.ID)
+	}
+
+	assertHistoryLen(t, f.svc, len(tests))
+}
+
+func TestPomodoroServiceRecover(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		state         storage.PomodoroState
+		downtime      time.Duration
+		wantState     event.PomodoroState
+		wantRemaining time.Duration
+		wantEvent     event.EventType
+	}{
+		{
+			name:          "active with time left",
+			state:         storage.PomodoroStateActive,
+			downtime:      10 * time.Minute,
+			wantState:     event.PomodoroStateActive,
+			wantRemaining: 10 * time.Minute,
+			wantEvent:     event.PomodoroResumed,
+		},
+		{
+			name:          "active past deadline",
+			state:         storage.PomodoroStateActive,
+			downtime:      time.Hour,
+			wantState:     event.PomodoroStateFinished,
+			wantRemaining: 0,
+			wantEvent:     event.PomodoroCompleted,
+		},
+		{
+			name:          "paused",
+			state:         storage.PomodoroStatePaused,
+			downtime:      time.Hour,
+			wantState:     event.PomodoroStatePaused,
+			wantRemaining: 20 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := newPomodoroFixture(t)
+			events := f.subscribe(t, event.PomodoroResumed, event.PomodoroCompleted)
+
+			err := f.storage.SavePomodoro(&storage.Pomodoro{
+				ID:             "interrupted",
+				State:          tt.state,
+				StartTime:      epoch,
+				UpdatedAt:      epoch.Add(5 * time.Minute),
+				WorkDuration:   workDuration,
+				RemainingTime:  20 * time.Minute,
+				ElapsedTime:    5 * time.Minute,
+				Phase:          storage.PomodoroPhaseWork,
+				PhaseDuration:  workDuration,
+				PhaseCount:     1,
+				BreakFrequency: breakFrequency,
+			})
+			if err != nil {
+				t.Fatalf("SavePomodoro() error = %v", err)
+			}
+
+			f.clock.Advance(5*time.Minute + tt.downtime)
+
+			p, err := f.svc.Recover(context.Background())
+			if err != nil {
+				t.Fatalf("Recover() error = %v", err)
+			}
+
+			if p.State != tt.wantState {
+				t.Errorf("State = %s, want %s", p.State, tt.wantState)
+			}
+
+			if p.RemainingTime != tt.wantRemaining {
+				t.Errorf("RemainingTime = %v, want %v", p.RemainingTime, tt.wantRemaining)
+			}
+
+			if tt.wantEvent != "" {
+				assertEvent(t, waitForEvent(t, events), tt.wantEvent, p.ID)
+			}
+		})
+	}
+}
+
+func TestPomodoroServiceRecoverAfterResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newPomodoroFixture(t)
+	p := f.start(t)
+
+	f.clock.Advance(10 * time.Minute)
+
+	if _, err := f.svc.Pause(ctx, p.ID); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+
+	f.clock.Advance(time.Hour)
+
+	if _, err := f.svc.Resume(ctx, p.ID); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+
+	// The server goes down right after the resume and starts again 10 minutes later.
+	restartedClock := clock.NewFake(f.clock.Now().Add(10 * time.Minute))
+	restarted := core.NewPomodoroService(f.storage, f.bus, core.WithClock(restartedClock))
+
+	recovered, err := restarted.Recover(ctx)
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+
+	if recovered.State != event.PomodoroStateActive || recovered.RemainingTime != 5*time.Minute {
+		t.Errorf("Recover() = %s with %v remaining, want active with 5m remaining", recovered.State, recovered.RemainingTime)
+	}
+}
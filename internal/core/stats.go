@@ -0,0 +1,270 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+// StatsGroupBy is the dimension sessions are grouped by.
+type StatsGroupBy string
+
+const (
+	// StatsGroupByDay groups sessions by the calendar day they started on.
+	StatsGroupByDay StatsGroupBy = "day"
+	// StatsGroupByWeek groups sessions by the ISO week, starting on Monday, they started in.
+	StatsGroupByWeek StatsGroupBy = "week"
+	// StatsGroupByTask groups sessions by task.
+	StatsGroupByTask StatsGroupBy = "task"
+	// StatsGroupByProject groups sessions by the project of their task.
+	StatsGroupByProject StatsGroupBy = "project"
+	// StatsGroupByTag groups sessions by the tags of their task.
+	// A session whose task has several tags counts towards each of them.
+	StatsGroupByTag StatsGroupBy = "tag"
+)
+
+// ParseStatsGroupBy returns the grouping with the given name.
+func ParseStatsGroupBy(name string) (StatsGroupBy, error) {
+	switch groupBy := StatsGroupBy(name); groupBy {
+	case StatsGroupByDay, StatsGroupByWeek, StatsGroupByTask, StatsGroupByProject, StatsGroupByTag:
+		return groupBy, nil
+	default:
+		return "", fmt.Errorf("unknown stats grouping: %s", name)
+	}
+}
+
+// FocusStats aggregates the work sessions of a period.
+type FocusStats struct {
+	// FocusTime is the time spent in work sessions, including interrupted ones.
+	FocusTime time.Duration
+	// Sessions is the number of work sessions that ran to completion.
+	Sessions int
+	// Interruptions is the number of work sessions that were stopped before completion.
+	Interruptions int
+	// BreakAdherence is the share of completed work sessions followed by a completed break,
+	// counting only the sessions that something followed. It is nil when there is none.
+	BreakAdherence *float64
+
+	breaksDue   int
+	breaksTaken int
+}
+
+// StatsGroup is the part of the stats that falls into one group.
+type StatsGroup struct {
+	// Key identifies the group: the start date for days and weeks, or the task ID, project or tag.
+	// Sessions without a task, project or tag are grouped under an empty key.
+	Key string
+	// Start and End bound the period of a day or week group. They are zero for other groups.
+	Start time.Time
+	End   time.Time
+	FocusStats
+}
+
+// Stats aggregates the work sessions started within a range.
+type Stats struct {
+	Start  time.Time
+	End    time.Time
+	Total  FocusStats
+	Groups []*StatsGroup
+}
+
+// StatsService aggregates the pomodoro history.
+type StatsService struct {
+	storage storage.Storage
+}
+
+// NewStatsService creates a new stats service instance.
+func NewStatsService(storage storage.Storage) *StatsService {
+	return &StatsService{
+		storage: storage,
+	}
+}
+
+// Stats aggregates the work sessions started within [start, end), grouped by groupBy.
+// Days and weeks follow the calendar in the location of start.
+func (s *StatsService) Stats(start, end time.Time, groupBy StatsGroupBy) (*Stats, error) {
+	if !start.Before(end) {
+		return nil, fmt.Errorf("start must be before end")
+	}
+
+	if _, err := ParseStatsGroupBy(string(groupBy)); err != nil {
+		return nil, err
+	}
+
+	history, err := s.storage.GetPomodoroHistory(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	// The break after the last session in range may start after end, so it is looked up on its own.
+	if n := len(history); n > 0 && history[n-1].Phase == storage.PomodoroPhaseWork {
+		page, err := s.storage.QueryPomodoroHistory(storage.PomodoroQuery{After: history[n-1].ID, First: 1})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the session after %s: %w", end, err)
+		}
+
+		history = append(history, page.Pomodoros...)
+	}
+
+	tasks, err := s.tasksByID(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{Start: start, End: end}
+	groups := make(map[string]*StatsGroup)
+
+	for i, p := range history {
+		if !p.StartTime.Before(end) {
+			break
+		}
+
+		if p.Phase != storage.PomodoroPhaseWork {
+			continue
+		}
+
+		var next *storage.Pomodoro
+		if i+1 < len(history) {
+			next = history[i+1]
+		}
+
+		stats.Total.add(p, next)
+
+		for _, group := range groupsOf(p, groupBy, tasks, start.Location(), groups) {
+			group.add(p, next)
+		}
+	}
+
+	stats.Total.finish()
+
+	for _, group := range groups {
+		group.finish()
+		stats.Groups = append(stats.Groups, group)
+	}
+
+	slices.SortFunc(stats.Groups, func(a, b *StatsGroup) int {
+		if groupBy == StatsGroupByDay || groupBy == StatsGroupByWeek {
+			return a.Start.Compare(b.Start)
+		}
+
+		return cmp.Or(cmp.Compare(b.FocusTime, a.FocusTime), cmp.Compare(a.Key, b.Key))
+	})
+
+	return stats, nil
+}
+
+// tasksByID loads the tasks when the grouping needs them.
+func (s *StatsService) tasksByID(groupBy StatsGroupBy) (map[string]*storage.Task, error) {
+	if groupBy != StatsGroupByProject && groupBy != StatsGroupByTag {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	tasks, err := s.storage.GetTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	byID := make(map[string]*storage.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+
+	return byID, nil
+}
+
+// groupsOf returns the groups a session belongs to, creating them as needed.
+func groupsOf(
+	p *storage.Pomodoro,
+	groupBy StatsGroupBy,
+	tasks map[string]*storage.Task,
+	loc *time.Location,
+	groups map[string]*StatsGroup,
+) []*StatsGroup {
+	group := func(key string, start, end time.Time) *StatsGroup {
+		g, ok := groups[key]
+		if !ok {
+			g = &StatsGroup{Key: key, Start: start, End: end}
+			groups[key] = g
+		}
+
+		return g
+	}
+
+	switch groupBy {
+	case StatsGroupByDay:
+		t := p.StartTime.In(loc)
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+
+		return []*StatsGroup{group(day.Format(time.DateOnly), day, day.AddDate(0, 0, 1))}
+	case StatsGroupByWeek:
+		t := p.StartTime.In(loc)
+		// Weekday counts from Sunday, while ISO weeks start on Monday.
+		offset := (int(t.Weekday()) + 6) % 7 //nolint:mnd
+		week := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, loc)
+
+		return []*StatsGroup{group(week.Format(time.DateOnly), week, week.AddDate(0, 0, 7))} //nolint:mnd
+	case StatsGroupByTask:
+		return []*StatsGroup{group(p.TaskID, time.Time{}, time.Time{})}
+	case StatsGroupByProject:
+		var project string
+		if task, ok := tasks[p.TaskID]; ok {
+			project = task.Project
+		}
+
+		return []*StatsGroup{group(project, time.Time{}, time.Time{})}
+	case StatsGroupByTag:
+		task, ok := tasks[p.TaskID]
+		if !ok || len(task.Tags) == 0 {
+			return []*StatsGroup{group("", time.Time{}, time.Time{})}
+		}
+
+		result := make([]*StatsGroup, len(task.Tags))
+		for i, tag := range task.Tags {
+			result[i] = group(tag, time.Time{}, time.Time{})
+		}
+
+		return result
+	default:
+		return nil
+	}
+}
+
+// add counts a work session and the session that followed it, if any.
+func (f *FocusStats) add(p, next *storage.Pomodoro) {
+	f.FocusTime += p.ElapsedTime
+
+	if !ranToCompletion(p) {
+		f.Interruptions++
+		return
+	}
+
+	f.Sessions++
+
+	if next == nil {
+		return
+	}
+
+	f.breaksDue++
+
+	if next.Phase != storage.PomodoroPhaseWork && ranToCompletion(next) {
+		f.breaksTaken++
+	}
+}
+
+// ranToCompletion reports whether a recorded session lasted its whole phase, like Pomodoro.Interrupted.
+func ranToCompletion(p *storage.Pomodoro) bool {
+	return p.ElapsedTime >= p.PhaseDuration
+}
+
+func (f *FocusStats) finish() {
+	if f.breaksDue == 0 {
+		return
+	}
+
+	adherence := float64(f.breaksTaken) / float64(f.breaksDue)
+	f.BreakAdherence = &adherence
+}
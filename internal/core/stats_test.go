@@ -0,0 +1,155 @@
+package core_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+)
+
+func newStatsService(t *testing.T) *core.StatsService {
+	t.Helper()
+
+	s := memory.NewMemoryStorage()
+
+	for _, task := range []*storage.Task{
+		{ID: "a", Title: "a", Project: "gomodoro", Tags: []string{"core", "test"}},
+		{ID: "b", Title: "b", Tags: []string{"docs"}},
+	} {
+		if err := s.SaveTask(task); err != nil {
+			t.Fatalf("SaveTask() error = %v", err)
+		}
+	}
+
+	// epoch is Wednesday, 2025-01-01 09:00.
+	history := []struct {
+		offset        time.Duration
+		phase         storage.PomodoroPhase
+		taskID        string
+		elapsed       time.Duration
+		phaseDuration time.Duration
+	}{
+		{0, storage.PomodoroPhaseWork, "a", 25 * time.Minute, 25 * time.Minute},
+		{25 * time.Minute, storage.PomodoroPhaseShortBreak, "a", 5 * time.Minute, 5 * time.Minute},
+		{30 * time.Minute, storage.PomodoroPhaseWork, "a", 10 * time.Minute, 25 * time.Minute},
+		{time.Hour, storage.PomodoroPhaseWork, "b", 25 * time.Minute, 25 * time.Minute},
+		{85 * time.Minute, storage.PomodoroPhaseShortBreak, "b", time.Minute, 5 * time.Minute},
+		{24 * time.Hour, storage.PomodoroPhaseWork, "b", 25 * time.Minute, 25 * time.Minute},
+		// The following Monday, for a task that has been deleted.
+		{5 * 24 * time.Hour, storage.PomodoroPhaseWork, "c", 25 * time.Minute, 25 * time.Minute},
+	}
+
+	for i, h := range history {
+		err := s.AddPomodoroHistory(&storage.Pomodoro{
+			ID:            string(rune('1' + i)),
+			State:         storage.PomodoroStateFinished,
+			StartTime:     epoch.Add(h.offset),
+			EndTime:       epoch.Add(h.offset + h.elapsed),
+			Phase:         h.phase,
+			TaskID:        h.taskID,
+			ElapsedTime:   h.elapsed,
+			PhaseDuration: h.phaseDuration,
+		})
+		if err != nil {
+			t.Fatalf("AddPomodoroHistory() error = %v", err)
+		}
+	}
+
+	return core.NewStatsService(s)
+}
+
+func TestStatsServiceTotal(t *testing.T) {
+	t.Parallel()
+
+	svc := newStatsService(t)
+
+	stats, err := svc.Stats(epoch.Add(-time.Hour), epoch.AddDate(0, 0, 7), core.StatsGroupByDay)
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+
+	total := stats.Total
+	if total.FocusTime != 110*time.Minute || total.Sessions != 4 || total.Interruptions != 1 {
+		t.Errorf("Total = %v/%d/%d, want %v/4/1", total.FocusTime, total.Sessions, total.Interruptions, 110*time.Minute)
+	}
+
+	// Of the three completed sessions that something followed, only the first was followed by a completed break.
+	if total.BreakAdherence == nil || *total.BreakAdherence != 1.0/3 {
+		t.Errorf("BreakAdherence = %v, want 1/3", total.BreakAdherence)
+	}
+
+	// The break after the last session in range still counts, although it starts at the end of the range.
+	stats, err = svc.Stats(epoch.Add(time.Hour), epoch.Add(85*time.Minute), core.StatsGroupByDay)
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if stats.Total.BreakAdherence == nil || *stats.Total.BreakAdherence != 0 {
+		t.Errorf("BreakAdherence = %v, want 0", stats.Total.BreakAdherence)
+	}
+
+	if _, err := svc.Stats(epoch, epoch, core.StatsGroupByDay); err == nil {
+		t.Error("Stats() with an empty range succeeded, want error")
+	}
+
+	if _, err := svc.Stats(epoch, epoch.Add(time.Hour), "month"); err == nil {
+		t.Error("Stats() with an unknown grouping succeeded, want error")
+	}
+}
+
+func TestStatsServiceGroups(t *testing.T) {
+	t.Parallel()
+
+	type group struct {
+		key       string
+		focusTime time.Duration
+	}
+
+	tests := []struct {
+		groupBy core.StatsGroupBy
+		want    []group
+	}{
+		{
+			groupBy: core.StatsGroupByDay,
+			want:    []group{{"2025-01-01", 60 * time.Minute}, {"2025-01-02", 25 * time.Minute}, {"2025-01-06", 25 * time.Minute}},
+		},
+		{
+			groupBy: core.StatsGroupByWeek,
+			want:    []group{{"2024-12-30", 85 * time.Minute}, {"2025-01-06", 25 * time.Minute}},
+		},
+		{
+			groupBy: core.StatsGroupByTask,
+			want:    []group{{"b", 50 * time.Minute}, {"a", 35 * time.Minute}, {"c", 25 * time.Minute}},
+		},
+		{
+			groupBy: core.StatsGroupByProject,
+			want:    []group{{"", 75 * time.Minute}, {"gomodoro", 35 * time.Minute}},
+		},
+		{
+			groupBy: core.StatsGroupByTag,
+			want: []group{
+				{"docs", 50 * time.Minute}, {"core", 35 * time.Minute}, {"test", 35 * time.Minute}, {"", 25 * time.Minute},
+			},
+		},
+	}
+
+	svc := newStatsService(t)
+
+	for _, tt := range tests {
+		stats, err := svc.Stats(epoch.Add(-time.Hour), epoch.AddDate(0, 0, 7), tt.groupBy)
+		if err != nil {
+			t.Fatalf("%s: Stats() error = %v", tt.groupBy, err)
+		}
+
+		got := make([]group, len(stats.Groups))
+		for i, g := range stats.Groups {
+			got[i] = group{g.Key, g.FocusTime}
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: groups = %v, want %v", tt.groupBy, got, tt.want)
+		}
+	}
+}
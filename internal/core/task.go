@@ -3,45 +3,112 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/hatappi/gomodoro/internal/clock"
 	"github.com/hatappi/gomodoro/internal/core/event"
 	"github.com/hatappi/gomodoro/internal/storage"
 )
 
 // Task represents a task with its current state.
 type Task struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"created_at"`
+	ID                 string           `json:"id"`
+	Title              string           `json:"title"`
+	Project            string           `json:"project,omitempty"`
+	Tags               []string         `json:"tags,omitempty"`
+	EstimatedPomodoros int              `json:"estimated_pomodoros,omitempty"`
+	Notes              string           `json:"notes,omitempty"`
+	Status             event.TaskStatus `json:"status"`
+	CreatedAt          time.Time        `json:"created_at"`
+}
+
+// taskTransitions lists the statuses a task can move to from each status.
+// An archived task has to be reopened before it can be worked on again.
+var taskTransitions = map[event.TaskStatus][]event.TaskStatus{
+	event.TaskStatusTodo:       {event.TaskStatusInProgress, event.TaskStatusDone, event.TaskStatusArchived},
+	event.TaskStatusInProgress: {event.TaskStatusTodo, event.TaskStatusDone, event.TaskStatusArchived},
+	event.TaskStatusDone:       {event.TaskStatusTodo, event.TaskStatusInProgress, event.TaskStatusArchived},
+	event.TaskStatusArchived:   {event.TaskStatusTodo},
+}
+
+// IsOpen reports whether the task still needs work, i.e. it is neither done nor archived.
+func (t *Task) IsOpen() bool {
+	return t.Status != event.TaskStatusDone && t.Status != event.TaskStatusArchived
+}
+
+// TaskDetails holds the optional metadata of a task.
+type TaskDetails struct {
+	Project            string
+	Tags               []string
+	EstimatedPomodoros int
+	// Notes is free-form markdown.
+	Notes string
+}
+
+// TaskUpdate holds the fields to change on a task. Nil fields are left unchanged.
+type TaskUpdate struct {
+	Title              *string
+	Project            *string
+	Tags               *[]string
+	EstimatedPomodoros *int
+	Notes              *string
 }
 
 // TaskService provides operations for managing tasks.
 type TaskService struct {
 	storage  storage.TaskStorage
 	eventBus event.EventBus
+	clock    clock.Clock
+}
+
+// TaskServiceOption configures a TaskService.
+type TaskServiceOption func(*TaskService)
+
+// WithTaskClock sets the clock the service stamps tasks and events with.
+func WithTaskClock(c clock.Clock) TaskServiceOption {
+	return func(s *TaskService) {
+		s.clock = c
+	}
 }
 
 // NewTaskService creates a new task service instance.
-func NewTaskService(storage storage.TaskStorage, eventBus event.EventBus) *TaskService {
-	return &TaskService{
+func NewTaskService(storage storage.TaskStorage, eventBus event.EventBus, opts ...TaskServiceOption) *TaskService {
+	s := &TaskService{
 		storage:  storage,
 		eventBus: eventBus,
+		clock:    clock.New(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 // CreateTask creates a new task.
-func (s *TaskService) CreateTask(_ context.Context, title string) (*Task, error) {
+func (s *TaskService) CreateTask(_ context.Context, title string, details TaskDetails) (*Task, error) {
 	if title == "" {
 		return nil, fmt.Errorf("task title cannot be empty")
 	}
 
+	if details.EstimatedPomodoros < 0 {
+		return nil, fmt.Errorf("estimated pomodoros cannot be negative")
+	}
+
 	task := &storage.Task{
-		ID:        uuid.New().String(),
-		Title:     title,
-		CreatedAt: time.Now(),
+		ID:                 uuid.New().String(),
+		Title:              title,
+		Project:            strings.TrimSpace(details.Project),
+		Tags:               normalizeTags(details.Tags),
+		EstimatedPomodoros: details.EstimatedPomodoros,
+		Notes:              details.Notes,
+		Status:             storage.TaskStatusTodo,
+		CreatedAt:          s.clock.Now(),
 	}
 
 	if err := s.storage.SaveTask(task); err != nil {
@@ -68,6 +135,34 @@ func (s *TaskService) GetAllTasks() ([]*Task, error) {
 	return result, nil
 }
 
+// TaskPage is a page of tasks selected by a storage.TaskQuery.
+type TaskPage struct {
+	Tasks           []*Task
+	TotalCount      int
+	HasPreviousPage bool
+	HasNextPage     bool
+}
+
+// ListTasks retrieves the page of tasks selected by the query.
+func (s *TaskService) ListTasks(query storage.TaskQuery) (*TaskPage, error) {
+	page, err := s.storage.QueryTasks(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+
+	tasks := make([]*Task, len(page.Tasks))
+	for i, task := range page.Tasks {
+		tasks[i] = s.storageTaskToCore(task)
+	}
+
+	return &TaskPage{
+		Tasks:           tasks,
+		TotalCount:      page.TotalCount,
+		HasPreviousPage: page.HasPreviousPage,
+		HasNextPage:     page.HasNextPage,
+	}, nil
+}
+
 // GetTaskByID retrieves a task by its ID.
 func (s *TaskService) GetTaskByID(id string) (*Task, error) {
 	task, err := s.storage.GetTaskByID(id)
@@ -79,14 +174,38 @@ func (s *TaskService) GetTaskByID(id string) (*Task, error) {
 }
 
 // UpdateTask updates an existing task with the provided information.
-func (s *TaskService) UpdateTask(_ context.Context, id string, title string) (*Task, error) {
+func (s *TaskService) UpdateTask(_ context.Context, id string, update TaskUpdate) (*Task, error) {
 	task, err := s.storage.GetTaskByID(id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task: %w", err)
 	}
 
-	if title != "" {
-		task.Title = title
+	if update.Title != nil {
+		if *update.Title == "" {
+			return nil, fmt.Errorf("task title cannot be empty")
+		}
+
+		task.Title = *update.Title
+	}
+
+	if update.Project != nil {
+		task.Project = strings.TrimSpace(*update.Project)
+	}
+
+	if update.Tags != nil {
+		task.Tags = normalizeTags(*update.Tags)
+	}
+
+	if update.EstimatedPomodoros != nil {
+		if *update.EstimatedPomodoros < 0 {
+			return nil, fmt.Errorf("estimated pomodoros cannot be negative")
+		}
+
+		task.EstimatedPomodoros = *update.EstimatedPomodoros
+	}
+
+	if update.Notes != nil {
+		task.Notes = *update.Notes
 	}
 
 	if err := s.storage.UpdateTask(task); err != nil {
@@ -98,6 +217,56 @@ func (s *TaskService) UpdateTask(_ context.Context, id string, title string) (*T
 	return s.storageTaskToCore(task), nil
 }
 
+// TransitionTask moves a task to the given status.
+// Moving a task to done or archived publishes TaskCompleted or TaskArchived instead of TaskUpdated.
+func (s *TaskService) TransitionTask(_ context.Context, id string, status event.TaskStatus) (*Task, error) {
+	task, err := s.storage.GetTaskByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	current := taskStatus(task.Status)
+	if current == status {
+		return s.storageTaskToCore(task), nil
+	}
+
+	allowed, ok := taskTransitions[current]
+	if !ok {
+		return nil, fmt.Errorf("unknown task status: %s", current)
+	}
+
+	if !slices.Contains(allowed, status) {
+		return nil, fmt.Errorf("cannot move task from %s to %s", current, status)
+	}
+
+	task.Status = storage.TaskStatus(status)
+
+	if err := s.storage.UpdateTask(task); err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	switch status {
+	case event.TaskStatusDone:
+		s.publishTaskEvent(event.TaskCompleted, task)
+	case event.TaskStatusArchived:
+		s.publishTaskEvent(event.TaskArchived, task)
+	case event.TaskStatusTodo, event.TaskStatusInProgress:
+		s.publishTaskEvent(event.TaskUpdated, task)
+	}
+
+	return s.storageTaskToCore(task), nil
+}
+
+// CompleteTask marks a task as done.
+func (s *TaskService) CompleteTask(ctx context.Context, id string) (*Task, error) {
+	return s.TransitionTask(ctx, id, event.TaskStatusDone)
+}
+
+// ArchiveTask archives a task, hiding it from the task list while keeping its history.
+func (s *TaskService) ArchiveTask(ctx context.Context, id string) (*Task, error) {
+	return s.TransitionTask(ctx, id, event.TaskStatusArchived)
+}
+
 // DeleteTask removes a task from storage by its ID.
 func (s *TaskService) DeleteTask(_ context.Context, id string) error {
 	task, err := s.storage.GetTaskByID(id)
@@ -118,10 +287,15 @@ func (s *TaskService) publishTaskEvent(eventType event.EventType, t *storage.Tas
 	e := event.TaskEvent{
 		BaseEvent: event.BaseEvent{
 			Type:      eventType,
-			Timestamp: time.Now(),
+			Timestamp: s.clock.Now(),
 		},
-		ID:    t.ID,
-		Title: t.Title,
+		ID:                 t.ID,
+		Title:              t.Title,
+		Project:            t.Project,
+		Tags:               t.Tags,
+		EstimatedPomodoros: t.EstimatedPomodoros,
+		Notes:              t.Notes,
+		Status:             taskStatus(t.Status),
 	}
 
 	s.eventBus.Publish(e)
@@ -133,8 +307,37 @@ func (s *TaskService) storageTaskToCore(t *storage.Task) *Task {
 	}
 
 	return &Task{
-		ID:        t.ID,
-		Title:     t.Title,
-		CreatedAt: t.CreatedAt,
+		ID:                 t.ID,
+		Title:              t.Title,
+		Project:            t.Project,
+		Tags:               t.Tags,
+		EstimatedPomodoros: t.EstimatedPomodoros,
+		Notes:              t.Notes,
+		Status:             taskStatus(t.Status),
+		CreatedAt:          t.CreatedAt,
 	}
 }
+
+// taskStatus converts a stored status, treating tasks saved before statuses existed as todo.
+func taskStatus(status storage.TaskStatus) event.TaskStatus {
+	return event.TaskStatus(storage.NormalizeTaskStatus(status))
+}
+
+// normalizeTags trims the tags and drops empty and duplicate ones, keeping the given order.
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) == 0 {
+		return nil
+	}
+
+	return normalized
+}
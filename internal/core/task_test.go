@@ -0,0 +1,210 @@
+package core_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/clock"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+)
+
+func waitForTaskEvent(t *testing.T, ch <-chan interface{}) event.TaskEvent {
+	t.Helper()
+
+	select {
+	case e := <-ch:
+		te, ok := e.(event.TaskEvent)
+		if !ok {
+			t.Fatalf("unexpected event %T", e)
+		}
+
+		return te
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	return event.TaskEvent{}
+}
+
+func TestTaskService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bus := event.NewInMemoryBus()
+	svc := core.NewTaskService(memory.NewMemoryStorage(), bus, core.WithTaskClock(clock.NewFake(epoch)))
+
+	events, unsubscribe := bus.SubscribeChannel([]event.EventType{event.TaskCreated, event.TaskUpdated, event.TaskDeleted})
+	defer unsubscribe()
+
+	if _, err := svc.CreateTask(ctx, "", core.TaskDetails{}); err == nil {
+		t.Error("CreateTask() with an empty title succeeded, want error")
+	}
+
+	if _, err := svc.CreateTask(ctx, "estimate", core.TaskDetails{EstimatedPomodoros: -1}); err == nil {
+		t.Error("CreateTask() with a negative estimate succeeded, want error")
+	}
+
+	task, err := svc.CreateTask(ctx, "write tests", core.TaskDetails{
+		Project:            " gomodoro ",
+		Tags:               []string{"test", " core ", "", "test"},
+		EstimatedPomodoros: 2,
+		Notes:              "- [ ] state machine",
+	})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+
+	if !task.CreatedAt.Equal(epoch) {
+		t.Errorf("CreatedAt = %v, want %v", task.CreatedAt, epoch)
+	}
+
+	if task.Project != "gomodoro" || !slices.Equal(task.Tags, []string{"test", "core"}) {
+		t.Errorf("project/tags = %q/%q, want %q/%q", task.Project, task.Tags, "gomodoro", []string{"test", "core"})
+	}
+
+	e := waitForTaskEvent(t, events)
+	if e.Type != event.TaskCreated || e.ID != task.ID || e.Title != "write tests" {
+		t.Errorf("event = %+v, want %s for %s", e, event.TaskCreated, task.ID)
+	}
+
+	if e.Project != "gomodoro" || e.EstimatedPomodoros != 2 || e.Notes != "- [ ] state machine" {
+		t.Errorf("event = %+v, want the task metadata", e)
+	}
+
+	title := "write more tests"
+	updated, err := svc.UpdateTask(ctx, task.ID, core.TaskUpdate{Title: &title, Tags: &[]string{}})
+	if err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+
+	if updated.Title != title || len(updated.Tags) != 0 {
+		t.Errorf("title/tags = %q/%q, want %q without tags", updated.Title, updated.Tags, title)
+	}
+
+	// Fields missing from the update are left unchanged.
+	if updated.Project != "gomodoro" || updated.EstimatedPomodoros != 2 || updated.Notes != "- [ ] state machine" {
+		t.Errorf("UpdateTask() = %+v, want the untouched metadata", updated)
+	}
+
+	e = waitForTaskEvent(t, events)
+	if e.Type != event.TaskUpdated || e.Title != title {
+		t.Errorf("event = %+v, want %s with the new title", e, event.TaskUpdated)
+	}
+
+	empty := ""
+	if _, err := svc.UpdateTask(ctx, task.ID, core.TaskUpdate{Title: &empty}); err == nil {
+		t.Error("UpdateTask() with an empty title succeeded, want error")
+	}
+
+	got, err := svc.GetTaskByID(task.ID)
+	if err != nil {
+		t.Fatalf("GetTaskByID() error = %v", err)
+	}
+
+	if got.Title != "write more tests" {
+		t.Errorf("stored Title = %q, want %q", got.Title, "write more tests")
+	}
+
+	if err := svc.DeleteTask(ctx, task.ID); err != nil {
+		t.Fatalf("DeleteTask() error = %v", err)
+	}
+
+	e = waitForTaskEvent(t, events)
+	if e.Type != event.TaskDeleted || e.ID != task.ID {
+		t.Errorf("event = %+v, want %s for %s", e, event.TaskDeleted, task.ID)
+	}
+
+	tasks, err := svc.GetAllTasks()
+	if err != nil {
+		t.Fatalf("GetAllTasks() error = %v", err)
+	}
+
+	if len(tasks) != 0 {
+		t.Errorf("len(GetAllTasks()) = %d, want 0", len(tasks))
+	}
+
+	if err := svc.DeleteTask(ctx, task.ID); err == nil {
+		t.Error("DeleteTask() of a deleted task succeeded, want error")
+	}
+}
+
+func TestTaskServiceTransitions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bus := event.NewInMemoryBus()
+	svc := core.NewTaskService(memory.NewMemoryStorage(), bus, core.WithTaskClock(clock.NewFake(epoch)))
+
+	task, err := svc.CreateTask(ctx, "ship it", core.TaskDetails{})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+
+	if task.Status != event.TaskStatusTodo || !task.IsOpen() {
+		t.Fatalf("Status = %s, want open %s", task.Status, event.TaskStatusTodo)
+	}
+
+	events, unsubscribe := bus.SubscribeChannel([]event.EventType{event.TaskUpdated, event.TaskCompleted, event.TaskArchived})
+	defer unsubscribe()
+
+	steps := []struct {
+		transition func() (*core.Task, error)
+		wantStatus event.TaskStatus
+		wantEvent  event.EventType
+	}{
+		{
+			transition: func() (*core.Task, error) { return svc.TransitionTask(ctx, task.ID, event.TaskStatusInProgress) },
+			wantStatus: event.TaskStatusInProgress,
+			wantEvent:  event.TaskUpdated,
+		},
+		{
+			transition: func() (*core.Task, error) { return svc.CompleteTask(ctx, task.ID) },
+			wantStatus: event.TaskStatusDone,
+			wantEvent:  event.TaskCompleted,
+		},
+		{
+			transition: func() (*core.Task, error) { return svc.ArchiveTask(ctx, task.ID) },
+			wantStatus: event.TaskStatusArchived,
+			wantEvent:  event.TaskArchived,
+		},
+		{
+			transition: func() (*core.Task, error) { return svc.TransitionTask(ctx, task.ID, event.TaskStatusTodo) },
+			wantStatus: event.TaskStatusTodo,
+			wantEvent:  event.TaskUpdated,
+		},
+	}
+
+	for _, step := range steps {
+		got, err := step.transition()
+		if err != nil {
+			t.Fatalf("transition to %s: error = %v", step.wantStatus, err)
+		}
+
+		if got.Status != step.wantStatus {
+			t.Errorf("Status = %s, want %s", got.Status, step.wantStatus)
+		}
+
+		e := waitForTaskEvent(t, events)
+		if e.Type != step.wantEvent || e.Status != step.wantStatus {
+			t.Errorf("event = %s/%s, want %s/%s", e.Type, e.Status, step.wantEvent, step.wantStatus)
+		}
+	}
+
+	if _, err := svc.ArchiveTask(ctx, task.ID); err != nil {
+		t.Fatalf("ArchiveTask() error = %v", err)
+	}
+
+	waitForTaskEvent(t, events)
+
+	if _, err := svc.CompleteTask(ctx, task.ID); err == nil {
+		t.Error("CompleteTask() of an archived task succeeded, want error")
+	}
+
+	if _, err := svc.TransitionTask(ctx, task.ID, event.TaskStatus("blocked")); err == nil {
+		t.Error("TransitionTask() to an unknown status succeeded, want error")
+	}
+}
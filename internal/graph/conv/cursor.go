@@ -0,0 +1,31 @@
+package conv
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Cursor prefixes keep the cursors of one connection from being accepted by another.
+const (
+	taskCursorPrefix     = "task:"
+	pomodoroCursorPrefix = "pomodoro:"
+)
+
+func encodeCursor(prefix, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(prefix + id))
+}
+
+func decodeCursor(prefix, cursor string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor: %s", cursor)
+	}
+
+	id, ok := strings.CutPrefix(string(decoded), prefix)
+	if !ok || id == "" {
+		return "", fmt.Errorf("invalid cursor: %s", cursor)
+	}
+
+	return id, nil
+}
@@ -53,12 +53,14 @@ func ConvertPomodoroEventToModelEvent(evt event.PomodoroEvent) (*model.Event, er
 		Phase:            phase,
 		PhaseCount:       evt.PhaseCount,
 		PhaseDurationSec: int(evt.PhaseDuration.Seconds()),
+		BreakFrequency:   evt.BreakFrequency,
 	}
 
 	return &model.Event{
 		EventCategory: model.EventCategoryPomodoro,
 		EventType:     eventType,
 		Payload:       payload,
+		Sequence:      ToOptional(int(evt.Sequence)),
 	}, nil
 }
 
@@ -70,17 +72,34 @@ func ConvertTaskEventToModelEvent(evt event.TaskEvent) (*model.Event, error) {
 	}
 
 	payload := &model.EventTaskPayload{
-		ID:    evt.ID,
-		Title: evt.Title,
+		ID:                 evt.ID,
+		Title:              evt.Title,
+		Project:            ToOptional(evt.Project),
+		Tags:               ToList(evt.Tags),
+		EstimatedPomodoros: ToOptional(evt.EstimatedPomodoros),
+		Notes:              ToOptional(evt.Notes),
+		Status:             fromTaskStatus(evt.Status),
 	}
 
 	return &model.Event{
 		EventCategory: model.EventCategoryTask,
 		EventType:     eventType,
 		Payload:       payload,
+		Sequence:      ToOptional(int(evt.Sequence)),
 	}, nil
 }
 
+// ConvertBusStatsToModel converts event bus counters to the GraphQL model.
+func ConvertBusStatsToModel(stats event.BusStats) *model.EventBusStats {
+	return &model.EventBusStats{
+		Subscribers:  stats.Subscribers,
+		Published:    int(stats.Published),    //nolint:gosec
+		Delivered:    int(stats.Delivered),    //nolint:gosec
+		Dropped:      int(stats.Dropped),      //nolint:gosec
+		Disconnected: int(stats.Disconnected), //nolint:gosec
+	}
+}
+
 func convertModelEventCategoryToEventTypes(mcat model.EventCategory) ([]event.EventType, error) {
 	switch mcat {
 	case model.EventCategoryPomodoro:
@@ -90,7 +109,7 @@ func convertModelEventCategoryToEventTypes(mcat model.EventCategory) ([]event.Ev
 		}, nil
 	case model.EventCategoryTask:
 		return []event.EventType{
-			event.TaskCreated, event.TaskUpdated, event.TaskDeleted,
+			event.TaskCreated, event.TaskUpdated, event.TaskDeleted, event.TaskCompleted, event.TaskArchived,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unknown event category: %s", mcat)
@@ -143,6 +162,10 @@ func convertEventTypeToModel(t event.EventType) (model.EventType, error) {
 		return model.EventTypeTaskUpdated, nil
 	case event.TaskDeleted:
 		return model.EventTypeTaskDeleted, nil
+	case event.TaskCompleted:
+		return model.EventTypeTaskCompleted, nil
+	case event.TaskArchived:
+		return model.EventTypeTaskArchived, nil
 	default:
 		return "", fmt.Errorf("unknown event type: %s", t)
 	}
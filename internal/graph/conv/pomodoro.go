@@ -1,8 +1,11 @@
 package conv
 
 import (
+	"fmt"
+
 	"github.com/hatappi/gomodoro/internal/core"
 	"github.com/hatappi/gomodoro/internal/graph/model"
+	"github.com/hatappi/gomodoro/internal/storage"
 )
 
 // FromPomodoro converts a core.Pomodoro to a model.Pomodoro.
@@ -32,5 +35,92 @@ func FromPomodoro(pomodoro *core.Pomodoro) (*model.Pomodoro, error) {
 		RemainingTimeSec: int(pomodoro.RemainingTime.Seconds()),
 		ElapsedTimeSec:   int(pomodoro.ElapsedTime.Seconds()),
 		PhaseDurationSec: int(pomodoro.PhaseDuration.Seconds()),
+		BreakFrequency:   pomodoro.BreakFrequency,
+		EndTime:          ToOptional(pomodoro.EndTime),
+		AutoAdvance:      pomodoro.AutoAdvance,
 	}, nil
 }
+
+// ToPomodoroQuery converts the arguments of the pomodoros query to a storage.PomodoroQuery.
+func ToPomodoroQuery(filter *model.PomodoroFilter, first *int, after *string) (storage.PomodoroQuery, error) {
+	var query storage.PomodoroQuery
+
+	if first != nil {
+		if *first <= 0 {
+			return query, fmt.Errorf("first must be positive")
+		}
+
+		query.First = *first
+	}
+
+	if after != nil {
+		id, err := decodeCursor(pomodoroCursorPrefix, *after)
+		if err != nil {
+			return query, err
+		}
+
+		query.After = id
+	}
+
+	if filter != nil {
+		query.TaskID = FromOptional(filter.TaskID)
+		query.StartedAfter = FromOptional(filter.StartedAfter)
+		query.StartedBefore = FromOptional(filter.StartedBefore)
+
+		for _, phase := range filter.Phase {
+			storagePhase, err := toPomodoroPhase(phase)
+			if err != nil {
+				return query, err
+			}
+
+			query.Phases = append(query.Phases, storagePhase)
+		}
+	}
+
+	return query, nil
+}
+
+// FromCorePomodoroPage converts a core.PomodoroPage to a model.PomodoroConnection.
+func FromCorePomodoroPage(page *core.PomodoroPage) (*model.PomodoroConnection, error) {
+	edges := make([]*model.PomodoroEdge, 0, len(page.Pomodoros))
+	for _, pomodoro := range page.Pomodoros {
+		node, err := FromPomodoro(pomodoro)
+		if err != nil {
+			return nil, err
+		}
+
+		edges = append(edges, &model.PomodoroEdge{
+			Cursor: encodeCursor(pomodoroCursorPrefix, pomodoro.ID),
+			Node:   node,
+		})
+	}
+
+	pageInfo := &model.PageInfo{
+		HasNextPage:     page.HasNextPage,
+		HasPreviousPage: page.HasPreviousPage,
+	}
+
+	if len(edges) > 0 {
+		pageInfo.StartCursor = ToPointer(edges[0].Cursor)
+		pageInfo.EndCursor = ToPointer(edges[len(edges)-1].Cursor)
+	}
+
+	return &model.PomodoroConnection{
+		Edges:      edges,
+		TotalCount: page.TotalCount,
+		PageInfo:   pageInfo,
+	}, nil
+}
+
+func toPomodoroPhase(phase model.PomodoroPhase) (storage.PomodoroPhase, error) {
+	switch phase {
+	case model.PomodoroPhaseWork:
+		return storage.PomodoroPhaseWork, nil
+	case model.PomodoroPhaseShortBreak:
+		return storage.PomodoroPhaseShortBreak, nil
+	case model.PomodoroPhaseLongBreak:
+		return storage.PomodoroPhaseLongBreak, nil
+	default:
+		return "", fmt.Errorf("unknown pomodoro phase: %s", phase)
+	}
+}
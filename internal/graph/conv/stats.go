@@ -0,0 +1,52 @@
+package conv
+
+import (
+	"fmt"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/graph/model"
+)
+
+// ToStatsGroupBy converts a model.StatsGroupBy to a core.StatsGroupBy.
+func ToStatsGroupBy(groupBy model.StatsGroupBy) (core.StatsGroupBy, error) {
+	switch groupBy {
+	case model.StatsGroupByDay:
+		return core.StatsGroupByDay, nil
+	case model.StatsGroupByWeek:
+		return core.StatsGroupByWeek, nil
+	case model.StatsGroupByTask:
+		return core.StatsGroupByTask, nil
+	case model.StatsGroupByProject:
+		return core.StatsGroupByProject, nil
+	case model.StatsGroupByTag:
+		return core.StatsGroupByTag, nil
+	default:
+		return "", fmt.Errorf("unknown stats grouping: %s", groupBy)
+	}
+}
+
+// FromCoreStats converts a core.Stats to a model.Stats.
+func FromCoreStats(stats *core.Stats) *model.Stats {
+	groups := make([]*model.StatsGroup, len(stats.Groups))
+	for i, group := range stats.Groups {
+		groups[i] = &model.StatsGroup{
+			Key:            group.Key,
+			Start:          ToOptional(group.Start),
+			End:            ToOptional(group.End),
+			FocusTimeSec:   int(group.FocusTime.Seconds()),
+			Sessions:       group.Sessions,
+			Interruptions:  group.Interruptions,
+			BreakAdherence: group.BreakAdherence,
+		}
+	}
+
+	return &model.Stats{
+		Start:          stats.Start,
+		End:            stats.End,
+		FocusTimeSec:   int(stats.Total.FocusTime.Seconds()),
+		Sessions:       stats.Total.Sessions,
+		Interruptions:  stats.Total.Interruptions,
+		BreakAdherence: stats.Total.BreakAdherence,
+		Groups:         groups,
+	}
+}
@@ -1,8 +1,12 @@
 package conv
 
 import (
+	"fmt"
+
 	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
 	"github.com/hatappi/gomodoro/internal/graph/model"
+	"github.com/hatappi/gomodoro/internal/storage"
 )
 
 // FromCoreTask converts a core.Task to a model.Task.
@@ -12,8 +16,185 @@ func FromCoreTask(task *core.Task) *model.Task {
 	}
 
 	return &model.Task{
-		ID:        task.ID,
-		Title:     task.Title,
-		CreatedAt: task.CreatedAt,
+		ID:                 task.ID,
+		Title:              task.Title,
+		Project:            ToOptional(task.Project),
+		Tags:               ToList(task.Tags),
+		EstimatedPomodoros: ToOptional(task.EstimatedPomodoros),
+		Notes:              ToOptional(task.Notes),
+		Status:             fromTaskStatus(task.Status),
+		CreatedAt:          task.CreatedAt,
+	}
+}
+
+// ToTaskStatus converts a model.TaskStatus to an event.TaskStatus.
+func ToTaskStatus(status model.TaskStatus) (event.TaskStatus, error) {
+	switch status {
+	case model.TaskStatusTodo:
+		return event.TaskStatusTodo, nil
+	case model.TaskStatusInProgress:
+		return event.TaskStatusInProgress, nil
+	case model.TaskStatusDone:
+		return event.TaskStatusDone, nil
+	case model.TaskStatusArchived:
+		return event.TaskStatusArchived, nil
+	default:
+		return "", fmt.Errorf("unknown task status: %s", status)
+	}
+}
+
+// fromTaskStatus converts an event.TaskStatus to a model.TaskStatus.
+// The core only produces known statuses, so anything else is reported as todo.
+func fromTaskStatus(status event.TaskStatus) model.TaskStatus {
+	switch status {
+	case event.TaskStatusInProgress:
+		return model.TaskStatusInProgress
+	case event.TaskStatusDone:
+		return model.TaskStatusDone
+	case event.TaskStatusArchived:
+		return model.TaskStatusArchived
+	case event.TaskStatusTodo:
+		return model.TaskStatusTodo
+	default:
+		return model.TaskStatusTodo
+	}
+}
+
+// ToCoreTaskDetails converts a model.CreateTaskInput to core.TaskDetails.
+func ToCoreTaskDetails(input model.CreateTaskInput) core.TaskDetails {
+	return core.TaskDetails{
+		Project:            FromOptional(input.Project),
+		Tags:               input.Tags,
+		EstimatedPomodoros: FromOptional(input.EstimatedPomodoros),
+		Notes:              FromOptional(input.Notes),
+	}
+}
+
+// ToCoreTaskUpdate converts a model.UpdateTaskInput to core.TaskUpdate.
+func ToCoreTaskUpdate(input model.UpdateTaskInput) core.TaskUpdate {
+	update := core.TaskUpdate{
+		Title:              input.Title,
+		Project:            input.Project,
+		EstimatedPomodoros: input.EstimatedPomodoros,
+		Notes:              input.Notes,
 	}
+
+	if input.Tags != nil {
+		update.Tags = &input.Tags
+	}
+
+	return update
+}
+
+// ToTaskQuery converts the arguments of the tasks query to a storage.TaskQuery.
+func ToTaskQuery(
+	first *int,
+	after *string,
+	last *int,
+	before *string,
+	filter *model.TaskFilter,
+	orderBy *model.TaskOrder,
+) (storage.TaskQuery, error) {
+	var query storage.TaskQuery
+
+	if first != nil {
+		if *first <= 0 {
+			return query, fmt.Errorf("first must be positive")
+		}
+
+		query.First = *first
+	}
+
+	if last != nil {
+		if *last <= 0 {
+			return query, fmt.Errorf("last must be positive")
+		}
+
+		query.Last = *last
+	}
+
+	if after != nil {
+		id, err := decodeTaskCursor(*after)
+		if err != nil {
+			return query, err
+		}
+
+		query.After = id
+	}
+
+	if before != nil {
+		id, err := decodeTaskCursor(*before)
+		if err != nil {
+			return query, err
+		}
+
+		query.Before = id
+	}
+
+	if filter != nil {
+		query.TitleContains = FromOptional(filter.TitleContains)
+		query.Tag = FromOptional(filter.Tag)
+		query.CreatedAfter = FromOptional(filter.CreatedAfter)
+		query.CreatedBefore = FromOptional(filter.CreatedBefore)
+
+		for _, status := range filter.Status {
+			taskStatus, err := ToTaskStatus(status)
+			if err != nil {
+				return query, err
+			}
+
+			query.Statuses = append(query.Statuses, storage.TaskStatus(taskStatus))
+		}
+	}
+
+	if orderBy != nil {
+		switch orderBy.Field {
+		case model.TaskSortFieldCreatedAt:
+			query.SortBy = storage.TaskSortCreatedAt
+		case model.TaskSortFieldTitle:
+			query.SortBy = storage.TaskSortTitle
+		default:
+			return query, fmt.Errorf("unknown task sort field: %s", orderBy.Field)
+		}
+
+		query.Descending = orderBy.Direction == model.SortDirectionDesc
+	}
+
+	return query, nil
+}
+
+// FromCoreTaskPage converts a core.TaskPage to a model.TaskConnection.
+func FromCoreTaskPage(page *core.TaskPage) *model.TaskConnection {
+	edges := make([]*model.TaskEdge, 0, len(page.Tasks))
+	for _, task := range page.Tasks {
+		edges = append(edges, &model.TaskEdge{
+			Cursor: EncodeTaskCursor(task.ID),
+			Node:   FromCoreTask(task),
+		})
+	}
+
+	pageInfo := &model.PageInfo{
+		HasNextPage:     page.HasNextPage,
+		HasPreviousPage: page.HasPreviousPage,
+	}
+
+	if len(edges) > 0 {
+		pageInfo.StartCursor = ToPointer(edges[0].Cursor)
+		pageInfo.EndCursor = ToPointer(edges[len(edges)-1].Cursor)
+	}
+
+	return &model.TaskConnection{
+		Edges:      edges,
+		TotalCount: page.TotalCount,
+		PageInfo:   pageInfo,
+	}
+}
+
+// EncodeTaskCursor returns the opaque cursor pointing at a task.
+func EncodeTaskCursor(id string) string {
+	return encodeCursor(taskCursorPrefix, id)
+}
+
+func decodeTaskCursor(cursor string) (string, error) {
+	return decodeCursor(taskCursorPrefix, cursor)
 }
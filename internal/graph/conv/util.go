@@ -4,3 +4,32 @@ package conv
 func ToPointer[T any](v T) *T {
 	return &v
 }
+
+// ToOptional converts a value to a pointer, mapping the zero value to nil.
+func ToOptional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+
+	return &v
+}
+
+// FromOptional dereferences a pointer, mapping nil to the zero value.
+func FromOptional[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
+
+// ToList returns an empty slice instead of nil for non-null GraphQL lists.
+func ToList[T any](v []T) []T {
+	if v == nil {
+		return []T{}
+	}
+
+	return v
+}
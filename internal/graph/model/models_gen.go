@@ -15,13 +15,26 @@ type EventPayload interface {
 }
 
 type CreateTaskInput struct {
-	Title string `json:"title"`
+	Title              string   `json:"title"`
+	Project            *string  `json:"project,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	EstimatedPomodoros *int     `json:"estimatedPomodoros,omitempty"`
+	Notes              *string  `json:"notes,omitempty"`
 }
 
 type Event struct {
 	EventCategory EventCategory `json:"eventCategory"`
 	EventType     EventType     `json:"eventType"`
 	Payload       EventPayload  `json:"payload"`
+	Sequence      *int          `json:"sequence,omitempty"`
+}
+
+type EventBusStats struct {
+	Subscribers  int `json:"subscribers"`
+	Published    int `json:"published"`
+	Delivered    int `json:"delivered"`
+	Dropped      int `json:"dropped"`
+	Disconnected int `json:"disconnected"`
 }
 
 type EventPomodoroPayload struct {
@@ -33,24 +46,32 @@ type EventPomodoroPayload struct {
 	Phase            PomodoroPhase `json:"phase"`
 	PhaseCount       int           `json:"phaseCount"`
 	PhaseDurationSec int           `json:"phaseDurationSec"`
+	BreakFrequency   int           `json:"breakFrequency"`
 }
 
 func (EventPomodoroPayload) IsEventPayload() {}
 
 type EventReceivedInput struct {
 	EventCategory []EventCategory `json:"eventCategory,omitempty"`
+	SinceSequence *int            `json:"sinceSequence,omitempty"`
 }
 
 type EventTaskPayload struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
+	ID                 string     `json:"id"`
+	Title              string     `json:"title"`
+	Project            *string    `json:"project,omitempty"`
+	Tags               []string   `json:"tags"`
+	EstimatedPomodoros *int       `json:"estimatedPomodoros,omitempty"`
+	Notes              *string    `json:"notes,omitempty"`
+	Status             TaskStatus `json:"status"`
 }
 
 func (EventTaskPayload) IsEventPayload() {}
 
 type HealthStatus struct {
-	Message   string    `json:"message"`
-	Timestamp time.Time `json:"timestamp"`
+	Message   string         `json:"message"`
+	Timestamp time.Time      `json:"timestamp"`
+	EventBus  *EventBusStats `json:"eventBus"`
 }
 
 type Mutation struct {
@@ -73,6 +94,28 @@ type Pomodoro struct {
 	RemainingTimeSec int           `json:"remainingTimeSec"`
 	ElapsedTimeSec   int           `json:"elapsedTimeSec"`
 	PhaseDurationSec int           `json:"phaseDurationSec"`
+	BreakFrequency   int           `json:"breakFrequency"`
+	EndTime          *time.Time    `json:"endTime,omitempty"`
+	Task             *Task         `json:"task,omitempty"`
+	AutoAdvance      bool          `json:"autoAdvance"`
+}
+
+type PomodoroConnection struct {
+	Edges      []*PomodoroEdge `json:"edges,omitempty"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type PomodoroEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Pomodoro `json:"node,omitempty"`
+}
+
+type PomodoroFilter struct {
+	TaskID        *string         `json:"taskId,omitempty"`
+	Phase         []PomodoroPhase `json:"phase,omitempty"`
+	StartedAfter  *time.Time      `json:"startedAfter,omitempty"`
+	StartedBefore *time.Time      `json:"startedBefore,omitempty"`
 }
 
 type Query struct {
@@ -82,16 +125,50 @@ type StartPomodoroInput struct {
 	WorkDurationSec      int    `json:"workDurationSec"`
 	BreakDurationSec     int    `json:"breakDurationSec"`
 	LongBreakDurationSec int    `json:"longBreakDurationSec"`
+	BreakFrequency       *int   `json:"breakFrequency,omitempty"`
 	TaskID               string `json:"taskId"`
+	AutoAdvance          *bool  `json:"autoAdvance,omitempty"`
+	SkipBreak            *bool  `json:"skipBreak,omitempty"`
+}
+
+type Stats struct {
+	Start          time.Time     `json:"start"`
+	End            time.Time     `json:"end"`
+	FocusTimeSec   int           `json:"focusTimeSec"`
+	Sessions       int           `json:"sessions"`
+	Interruptions  int           `json:"interruptions"`
+	BreakAdherence *float64      `json:"breakAdherence,omitempty"`
+	Groups         []*StatsGroup `json:"groups"`
+}
+
+type StatsGroup struct {
+	Key            string     `json:"key"`
+	Start          *time.Time `json:"start,omitempty"`
+	End            *time.Time `json:"end,omitempty"`
+	FocusTimeSec   int        `json:"focusTimeSec"`
+	Sessions       int        `json:"sessions"`
+	Interruptions  int        `json:"interruptions"`
+	BreakAdherence *float64   `json:"breakAdherence,omitempty"`
+}
+
+type StatsRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
 }
 
 type Subscription struct {
 }
 
 type Task struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID                 string              `json:"id"`
+	Title              string              `json:"title"`
+	Project            *string             `json:"project,omitempty"`
+	Tags               []string            `json:"tags"`
+	EstimatedPomodoros *int                `json:"estimatedPomodoros,omitempty"`
+	Notes              *string             `json:"notes,omitempty"`
+	Status             TaskStatus          `json:"status"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	Pomodoros          *PomodoroConnection `json:"pomodoros,omitempty"`
 }
 
 type TaskConnection struct {
@@ -105,9 +182,26 @@ type TaskEdge struct {
 	Node   *Task  `json:"node,omitempty"`
 }
 
+type TaskFilter struct {
+	TitleContains *string      `json:"titleContains,omitempty"`
+	Tag           *string      `json:"tag,omitempty"`
+	Status        []TaskStatus `json:"status,omitempty"`
+	CreatedAfter  *time.Time   `json:"createdAfter,omitempty"`
+	CreatedBefore *time.Time   `json:"createdBefore,omitempty"`
+}
+
+type TaskOrder struct {
+	Field     TaskSortField `json:"field"`
+	Direction SortDirection `json:"direction"`
+}
+
 type UpdateTaskInput struct {
-	ID    string  `json:"id"`
-	Title *string `json:"title,omitempty"`
+	ID                 string   `json:"id"`
+	Title              *string  `json:"title,omitempty"`
+	Project            *string  `json:"project,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+	EstimatedPomodoros *int     `json:"estimatedPomodoros,omitempty"`
+	Notes              *string  `json:"notes,omitempty"`
 }
 
 type EventCategory string
@@ -177,6 +271,8 @@ const (
 	EventTypeTaskCreated       EventType = "TASK_CREATED"
 	EventTypeTaskUpdated       EventType = "TASK_UPDATED"
 	EventTypeTaskDeleted       EventType = "TASK_DELETED"
+	EventTypeTaskCompleted     EventType = "TASK_COMPLETED"
+	EventTypeTaskArchived      EventType = "TASK_ARCHIVED"
 )
 
 var AllEventType = []EventType{
@@ -189,11 +285,13 @@ var AllEventType = []EventType{
 	EventTypeTaskCreated,
 	EventTypeTaskUpdated,
 	EventTypeTaskDeleted,
+	EventTypeTaskCompleted,
+	EventTypeTaskArchived,
 }
 
 func (e EventType) IsValid() bool {
 	switch e {
-	case EventTypePomodoroStarted, EventTypePomodoroPaused, EventTypePomodoroResumed, EventTypePomodoroCompleted, EventTypePomodoroStopped, EventTypePomodoroTick, EventTypeTaskCreated, EventTypeTaskUpdated, EventTypeTaskDeleted:
+	case EventTypePomodoroStarted, EventTypePomodoroPaused, EventTypePomodoroResumed, EventTypePomodoroCompleted, EventTypePomodoroStopped, EventTypePomodoroTick, EventTypeTaskCreated, EventTypeTaskUpdated, EventTypeTaskDeleted, EventTypeTaskCompleted, EventTypeTaskArchived:
 		return true
 	}
 	return false
@@ -347,3 +445,233 @@ func (e PomodoroState) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StatsGroupBy string
+
+const (
+	StatsGroupByDay     StatsGroupBy = "DAY"
+	StatsGroupByWeek    StatsGroupBy = "WEEK"
+	StatsGroupByTask    StatsGroupBy = "TASK"
+	StatsGroupByProject StatsGroupBy = "PROJECT"
+	StatsGroupByTag     StatsGroupBy = "TAG"
+)
+
+var AllStatsGroupBy = []StatsGroupBy{
+	StatsGroupByDay,
+	StatsGroupByWeek,
+	StatsGroupByTask,
+	StatsGroupByProject,
+	StatsGroupByTag,
+}
+
+func (e StatsGroupBy) IsValid() bool {
+	switch e {
+	case StatsGroupByDay, StatsGroupByWeek, StatsGroupByTask, StatsGroupByProject, StatsGroupByTag:
+		return true
+	}
+	return false
+}
+
+func (e StatsGroupBy) String() string {
+	return string(e)
+}
+
+func (e *StatsGroupBy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatsGroupBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatsGroupBy", str)
+	}
+	return nil
+}
+
+func (e StatsGroupBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StatsGroupBy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StatsGroupBy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TaskSortField string
+
+const (
+	TaskSortFieldCreatedAt TaskSortField = "CREATED_AT"
+	TaskSortFieldTitle     TaskSortField = "TITLE"
+)
+
+var AllTaskSortField = []TaskSortField{
+	TaskSortFieldCreatedAt,
+	TaskSortFieldTitle,
+}
+
+func (e TaskSortField) IsValid() bool {
+	switch e {
+	case TaskSortFieldCreatedAt, TaskSortFieldTitle:
+		return true
+	}
+	return false
+}
+
+func (e TaskSortField) String() string {
+	return string(e)
+}
+
+func (e *TaskSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskSortField", str)
+	}
+	return nil
+}
+
+func (e TaskSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TaskSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TaskSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TaskStatus string
+
+const (
+	TaskStatusTodo       TaskStatus = "TODO"
+	TaskStatusInProgress TaskStatus = "IN_PROGRESS"
+	TaskStatusDone       TaskStatus = "DONE"
+	TaskStatusArchived   TaskStatus = "ARCHIVED"
+)
+
+var AllTaskStatus = []TaskStatus{
+	TaskStatusTodo,
+	TaskStatusInProgress,
+	TaskStatusDone,
+	TaskStatusArchived,
+}
+
+func (e TaskStatus) IsValid() bool {
+	switch e {
+	case TaskStatusTodo, TaskStatusInProgress, TaskStatusDone, TaskStatusArchived:
+		return true
+	}
+	return false
+}
+
+func (e TaskStatus) String() string {
+	return string(e)
+}
+
+func (e *TaskStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskStatus", str)
+	}
+	return nil
+}
+
+func (e TaskStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TaskStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TaskStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -6,6 +6,7 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -22,13 +23,76 @@ func (r *subscriptionResolver) EventReceived(ctx context.Context, input model.Ev
 		return nil, err
 	}
 
-	busCh, unsubscribe := r.EventBus.SubscribeChannel(eventTypes)
+	// The subscription starts before the log is read so that no event falls between the replay and live delivery.
+	// A client that falls behind can subscribe again with sinceSequence, so it may be disconnected.
+	busCh, unsubscribe := r.EventBus.SubscribeChannel(
+		eventTypes,
+		event.WithSubscriberName("graphql"),
+		event.WithExternalSubscriber(),
+	)
+
+	var replay []event.EventInfo
+	if input.SinceSequence != nil {
+		if r.EventLog == nil {
+			unsubscribe()
+			return nil, fmt.Errorf("event replay is not available")
+		}
+
+		replay, err = r.EventLog.EventsSince(int64(*input.SinceSequence), eventTypes)
+		if err != nil {
+			unsubscribe()
+			return nil, fmt.Errorf("failed to replay events: %w", err)
+		}
+	}
+
 	outCh := make(chan *model.Event)
 
+	// send delivers an event and reports whether the subscription should go on.
+	send := func(e interface{}) bool {
+		var (
+			ev  *model.Event
+			err error
+		)
+
+		switch evt := e.(type) {
+		case event.PomodoroEvent:
+			ev, err = conv.ConvertPomodoroEventToModelEvent(evt)
+			if err != nil {
+				transport.AddSubscriptionError(ctx, gqlerror.Errorf("failed to convert pomodoro event: %s", err))
+				return false
+			}
+		case event.TaskEvent:
+			ev, err = conv.ConvertTaskEventToModelEvent(evt)
+			if err != nil {
+				transport.AddSubscriptionError(ctx, gqlerror.Errorf("failed to convert task event: %s", err))
+				return false
+			}
+		default:
+			transport.AddSubscriptionError(ctx, gqlerror.Errorf("unknown event type: %T", evt))
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case outCh <- ev:
+			return true
+		}
+	}
+
 	go func() {
 		defer close(outCh)
 		defer unsubscribe()
 
+		var replayed int64
+		for _, e := range replay {
+			if !send(e) {
+				return
+			}
+
+			replayed = e.GetSequence()
+		}
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -38,25 +102,13 @@ func (r *subscriptionResolver) EventReceived(ctx context.Context, input model.Ev
 					return
 				}
 
-				switch evt := e.(type) {
-				case event.PomodoroEvent:
-					ev, err := conv.ConvertPomodoroEventToModelEvent(evt)
-					if err != nil {
-						transport.AddSubscriptionError(ctx, gqlerror.Errorf("failed to convert pomodoro event: %s", err))
-						return
-					}
-
-					outCh <- ev
-				case event.TaskEvent:
-					ev, err := conv.ConvertTaskEventToModelEvent(evt)
-					if err != nil {
-						transport.AddSubscriptionError(ctx, gqlerror.Errorf("failed to convert task event: %s", err))
-						return
-					}
-
-					outCh <- ev
-				default:
-					transport.AddSubscriptionError(ctx, gqlerror.Errorf("unknown event type: %T", evt))
+				// Events published while the log was read are delivered live as well.
+				if info, ok := e.(event.EventInfo); ok && info.GetSequence() != 0 && info.GetSequence() <= replayed {
+					continue
+				}
+
+				if !send(e) {
+					return
 				}
 			}
 		}
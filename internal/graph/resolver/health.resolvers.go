@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hatappi/gomodoro/internal/graph/conv"
 	"github.com/hatappi/gomodoro/internal/graph/model"
 )
 
@@ -16,5 +17,6 @@ func (r *queryResolver) Health(ctx context.Context) (*model.HealthStatus, error)
 	return &model.HealthStatus{
 		Message:   "OK",
 		Timestamp: time.Now(),
+		EventBus:  conv.ConvertBusStatsToModel(r.EventBus.Stats()),
 	}, nil
 }
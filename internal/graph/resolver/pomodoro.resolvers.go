@@ -9,18 +9,36 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/graph"
 	"github.com/hatappi/gomodoro/internal/graph/conv"
 	"github.com/hatappi/gomodoro/internal/graph/model"
 )
 
 // StartPomodoro is the resolver for the startPomodoro field.
 func (r *mutationResolver) StartPomodoro(ctx context.Context, input model.StartPomodoroInput) (*model.Pomodoro, error) {
+	var breakFrequency int
+	if input.BreakFrequency != nil {
+		breakFrequency = *input.BreakFrequency
+	}
+
+	var opts []core.StartOption
+	if input.AutoAdvance != nil && *input.AutoAdvance {
+		opts = append(opts, core.WithAutoAdvance())
+	}
+
+	if input.SkipBreak != nil && *input.SkipBreak {
+		opts = append(opts, core.WithSkipBreak())
+	}
+
 	pomodoro, err := r.PomodoroService.Start(
 		ctx,
 		time.Duration(input.WorkDurationSec)*time.Second,
 		time.Duration(input.BreakDurationSec)*time.Second,
 		time.Duration(input.LongBreakDurationSec)*time.Second,
+		breakFrequency,
 		input.TaskID,
+		opts...,
 	)
 	if err != nil {
 		return nil, err
@@ -70,7 +88,12 @@ func (r *mutationResolver) StopPomodoro(ctx context.Context) (*model.Pomodoro, e
 		return nil, err
 	}
 
-	return conv.FromPomodoro(activePomodoro)
+	stoppedPomodoro, err := r.PomodoroService.GetPomodoroByID(activePomodoro.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromPomodoro(stoppedPomodoro)
 }
 
 // ResetPomodoro is the resolver for the resetPomodoro field.
@@ -83,6 +106,21 @@ func (r *mutationResolver) ResetPomodoro(ctx context.Context) (*model.Pomodoro,
 	return conv.FromPomodoro(pomodoro)
 }
 
+// Task is the resolver for the task field.
+func (r *pomodoroResolver) Task(ctx context.Context, obj *model.Pomodoro) (*model.Task, error) {
+	if obj.TaskID == "" {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	task, err := r.TaskService.GetTaskByID(obj.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromCoreTask(task), nil
+}
+
 // CurrentPomodoro is the resolver for the currentPomodoro field.
 func (r *queryResolver) CurrentPomodoro(ctx context.Context) (*model.Pomodoro, error) {
 	pomodoro, err := r.PomodoroService.LatestPomodoro()
@@ -96,3 +134,33 @@ func (r *queryResolver) CurrentPomodoro(ctx context.Context) (*model.Pomodoro, e
 
 	return conv.FromPomodoro(pomodoro)
 }
+
+// Pomodoros is the resolver for the pomodoros field.
+func (r *queryResolver) Pomodoros(ctx context.Context, filter *model.PomodoroFilter, first *int, after *string) (*model.PomodoroConnection, error) {
+	query, err := conv.ToPomodoroQuery(filter, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := r.PomodoroService.QueryHistory(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromCorePomodoroPage(page)
+}
+
+// Pomodoro is the resolver for the pomodoro field.
+func (r *queryResolver) Pomodoro(ctx context.Context, id string) (*model.Pomodoro, error) {
+	pomodoro, err := r.PomodoroService.GetPomodoroByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromPomodoro(pomodoro)
+}
+
+// Pomodoro returns graph.PomodoroResolver implementation.
+func (r *Resolver) Pomodoro() graph.PomodoroResolver { return &pomodoroResolver{r} }
+
+type pomodoroResolver struct{ *Resolver }
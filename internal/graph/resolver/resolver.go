@@ -15,7 +15,10 @@ import (
 // Resolver serves as the root resolver for the GraphQL schema.
 type Resolver struct {
 	EventBus event.EventBus
+	// EventLog replays recorded events to subscriptions. Replay is unavailable if it is nil.
+	EventLog *core.EventLog
 
 	TaskService     *core.TaskService
 	PomodoroService *core.PomodoroService
+	StatsService    *core.StatsService
 }
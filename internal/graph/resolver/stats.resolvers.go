@@ -0,0 +1,27 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+
+	"github.com/hatappi/gomodoro/internal/graph/conv"
+	"github.com/hatappi/gomodoro/internal/graph/model"
+)
+
+// Stats is the resolver for the stats field.
+func (r *queryResolver) Stats(ctx context.Context, rangeArg model.StatsRange, groupBy model.StatsGroupBy) (*model.Stats, error) {
+	coreGroupBy, err := conv.ToStatsGroupBy(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := r.StatsService.Stats(rangeArg.Start, rangeArg.End, coreGroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromCoreStats(stats), nil
+}
@@ -7,13 +7,14 @@ package resolver
 import (
 	"context"
 
+	"github.com/hatappi/gomodoro/internal/graph"
 	"github.com/hatappi/gomodoro/internal/graph/conv"
 	"github.com/hatappi/gomodoro/internal/graph/model"
 )
 
 // CreateTask is the resolver for the createTask field.
 func (r *mutationResolver) CreateTask(ctx context.Context, input model.CreateTaskInput) (*model.Task, error) {
-	task, err := r.TaskService.CreateTask(ctx, input.Title)
+	task, err := r.TaskService.CreateTask(ctx, input.Title, conv.ToCoreTaskDetails(input))
 	if err != nil {
 		return nil, err
 	}
@@ -23,7 +24,7 @@ func (r *mutationResolver) CreateTask(ctx context.Context, input model.CreateTas
 
 // UpdateTask is the resolver for the updateTask field.
 func (r *mutationResolver) UpdateTask(ctx context.Context, input model.UpdateTaskInput) (*model.Task, error) {
-	updatedTask, err := r.TaskService.UpdateTask(ctx, input.ID, *input.Title)
+	updatedTask, err := r.TaskService.UpdateTask(ctx, input.ID, conv.ToCoreTaskUpdate(input))
 	if err != nil {
 		return nil, err
 	}
@@ -40,38 +41,54 @@ func (r *mutationResolver) DeleteTask(ctx context.Context, id string) (*bool, er
 	return conv.ToPointer(true), nil
 }
 
-// Tasks is the resolver for the tasks field.
-func (r *queryResolver) Tasks(ctx context.Context) (*model.TaskConnection, error) {
-	tasks, err := r.TaskService.GetAllTasks()
+// UpdateTaskStatus is the resolver for the updateTaskStatus field.
+func (r *mutationResolver) UpdateTaskStatus(ctx context.Context, id string, status model.TaskStatus) (*model.Task, error) {
+	taskStatus, err := conv.ToTaskStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := r.TaskService.TransitionTask(ctx, id, taskStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromCoreTask(task), nil
+}
+
+// CompleteTask is the resolver for the completeTask field.
+func (r *mutationResolver) CompleteTask(ctx context.Context, id string) (*model.Task, error) {
+	task, err := r.TaskService.CompleteTask(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	var edges []*model.TaskEdge
-	for _, task := range tasks {
-		tm := conv.FromCoreTask(task)
+	return conv.FromCoreTask(task), nil
+}
 
-		edges = append(edges, &model.TaskEdge{
-			Cursor: tm.ID,
-			Node:   tm,
-		})
+// ArchiveTask is the resolver for the archiveTask field.
+func (r *mutationResolver) ArchiveTask(ctx context.Context, id string) (*model.Task, error) {
+	task, err := r.TaskService.ArchiveTask(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	pageInfo := &model.PageInfo{
-		HasNextPage:     false,
-		HasPreviousPage: false,
+	return conv.FromCoreTask(task), nil
+}
+
+// Tasks is the resolver for the tasks field.
+func (r *queryResolver) Tasks(ctx context.Context, first *int, after *string, last *int, before *string, filter *model.TaskFilter, orderBy *model.TaskOrder) (*model.TaskConnection, error) {
+	query, err := conv.ToTaskQuery(first, after, last, before, filter, orderBy)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(tasks) > 0 {
-		pageInfo.StartCursor = conv.ToPointer(edges[0].Cursor)
-		pageInfo.EndCursor = conv.ToPointer(edges[len(edges)-1].Cursor)
+	page, err := r.TaskService.ListTasks(query)
+	if err != nil {
+		return nil, err
 	}
 
-	return &model.TaskConnection{
-		Edges:      edges,
-		TotalCount: len(tasks),
-		PageInfo:   pageInfo,
-	}, nil
+	return conv.FromCoreTaskPage(page), nil
 }
 
 // Task is the resolver for the task field.
@@ -82,3 +99,23 @@ func (r *queryResolver) Task(ctx context.Context, id string) (*model.Task, error
 	}
 	return conv.FromCoreTask(task), nil
 }
+
+// Pomodoros is the resolver for the pomodoros field.
+func (r *taskResolver) Pomodoros(ctx context.Context, obj *model.Task, first *int, after *string) (*model.PomodoroConnection, error) {
+	query, err := conv.ToPomodoroQuery(&model.PomodoroFilter{TaskID: &obj.ID}, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := r.PomodoroService.QueryHistory(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return conv.FromCorePomodoroPage(page)
+}
+
+// Task returns graph.TaskResolver implementation.
+func (r *Resolver) Task() graph.TaskResolver { return &taskResolver{r} }
+
+type taskResolver struct{ *Resolver }
@@ -0,0 +1,14 @@
+//go:build !unix
+// +build !unix
+
+package hook
+
+import (
+	"context"
+	"os/exec"
+)
+
+// shellCommand runs the command with cmd.exe.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	return exec.CommandContext(ctx, "cmd", "/C", command) //nolint:gosec
+}
@@ -0,0 +1,22 @@
+//go:build unix
+// +build unix
+
+package hook
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+)
+
+// shellCommand runs the command with sh in its own process group,
+// so a command that runs out of time is killed together with its children.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command) //nolint:gosec
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+
+	return cmd
+}
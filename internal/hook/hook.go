@@ -0,0 +1,239 @@
+// Package hook runs shell commands on pomodoro and task events
+package hook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core/event"
+)
+
+const (
+	// EnvPrefix is the prefix of the environment variables that carry the event fields.
+	EnvPrefix = "GOMODORO_"
+
+	// waitDelay is how long a killed command may keep its output open, e.g. through a child process.
+	waitDelay = time.Second
+	// maxStderrSize is how much of the stderr of a failed command is logged.
+	maxStderrSize = 512
+)
+
+// Executor runs the commands configured for the events of the bus.
+type Executor struct {
+	commands map[event.EventType][]string
+	timeout  time.Duration
+	slots    chan struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new Executor from the configuration.
+func New(cfg config.HooksConfig) (*Executor, error) {
+	e := &Executor{
+		commands: make(map[event.EventType][]string, len(cfg.Events)),
+		timeout:  cfg.Timeout,
+		slots:    make(chan struct{}, cfg.MaxConcurrency),
+	}
+
+	for name, commands := range cfg.Events {
+		eventType := event.EventType(name)
+		if !slices.Contains(event.AllEventTypes, eventType) {
+			return nil, fmt.Errorf("unknown event type %q", name)
+		}
+
+		e.commands[eventType] = commands
+	}
+
+	return e, nil
+}
+
+// EventTypes returns the event types that have commands, in the order of event.AllEventTypes.
+func (e *Executor) EventTypes() []event.EventType {
+	var eventTypes []event.EventType
+	for _, eventType := range event.AllEventTypes {
+		if len(e.commands[eventType]) > 0 {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	return eventTypes
+}
+
+// Start subscribes to the bus and runs the commands of each event until ctx is done or Stop is called.
+// The commands of an event start in the configured order but run concurrently, up to the concurrency limit.
+func (e *Executor) Start(ctx context.Context, bus event.EventBus) {
+	ctx, e.cancel = context.WithCancel(ctx)
+
+	eventTypes := e.EventTypes()
+	if len(eventTypes) == 0 {
+		return
+	}
+
+	busCh, unsubscribe := bus.SubscribeChannel(eventTypes, event.WithSubscriberName("hooks"))
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-busCh:
+				if !ok {
+					return
+				}
+
+				if info, ok := evt.(event.EventInfo); ok {
+					e.runAll(ctx, info)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops receiving events and waits until the running commands exit or ctx is done.
+// Running commands are not interrupted, so a command that restores state still completes.
+func (e *Executor) Stop(ctx context.Context) error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for running hooks: %w", ctx.Err())
+	}
+}
+
+func (e *Executor) runAll(ctx context.Context, evt event.EventInfo) {
+	runCtx := context.WithoutCancel(ctx)
+
+	for _, command := range e.commands[evt.GetEventType()] {
+		select {
+		case <-ctx.Done():
+			return
+		case e.slots <- struct{}{}:
+		}
+
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			defer func() { <-e.slots }()
+
+			logger := log.FromContext(ctx).WithValues("event", evt.GetEventType(), "command", command)
+			start := time.Now()
+
+			exitCode, err := e.Run(runCtx, command, evt)
+			if err != nil {
+				logger.Error(err, "Hook failed", "exitCode", exitCode, "duration", time.Since(start))
+				return
+			}
+
+			logger.Info("Hook finished", "exitCode", exitCode, "duration", time.Since(start))
+		}()
+	}
+}
+
+// Run runs a command for an event and returns its exit code.
+// The event fields are passed as environment variables (see Env) and the event as JSON on stdin.
+// A command that exits with a non-zero code or runs out of time returns an error.
+func (e *Executor) Run(ctx context.Context, command string, evt event.EventInfo) (int, error) {
+	input, err := json.Marshal(evt)
+	if err != nil {
+		return -1, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+
+	cmd := shellCommand(ctx, command)
+	cmd.Env = append(os.Environ(), Env(evt)...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+
+	err = cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return -1, fmt.Errorf("timed out after %s", e.timeout)
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxStderrSize {
+			msg = msg[:maxStderrSize]
+		}
+
+		return exitErr.ExitCode(), fmt.Errorf("exited with code %d: %s", exitErr.ExitCode(), msg)
+	}
+
+	if err != nil {
+		return -1, fmt.Errorf("failed to run command: %w", err)
+	}
+
+	return 0, nil
+}
+
+// Env returns the environment variables that carry the fields of an event, e.g. GOMODORO_EVENT=pomodoro.started.
+// Durations are in whole seconds.
+func Env(evt event.EventInfo) []string {
+	env := []string{EnvPrefix + "EVENT=" + string(evt.GetEventType())}
+
+	if evt.GetSequence() > 0 {
+		env = append(env, EnvPrefix+"EVENT_SEQUENCE="+strconv.FormatInt(evt.GetSequence(), 10))
+	}
+
+	set := func(name, value string) {
+		env = append(env, EnvPrefix+name+"="+value)
+	}
+
+	switch e := evt.(type) {
+	case event.PomodoroEvent:
+		set("EVENT_TIMESTAMP", e.Timestamp.Format(time.RFC3339))
+		set("POMODORO_ID", e.ID)
+		set("POMODORO_STATE", string(e.State))
+		set("POMODORO_PHASE", string(e.Phase))
+		set("POMODORO_PHASE_COUNT", strconv.Itoa(e.PhaseCount))
+		set("POMODORO_PHASE_DURATION_SEC", strconv.Itoa(int(e.PhaseDuration.Seconds())))
+		set("POMODORO_REMAINING_SEC", strconv.Itoa(int(e.RemainingTime.Seconds())))
+		set("POMODORO_ELAPSED_SEC", strconv.Itoa(int(e.ElapsedTime.Seconds())))
+		set("POMODORO_BREAK_FREQUENCY", strconv.Itoa(e.BreakFrequency))
+		set("TASK_ID", e.TaskID)
+	case event.TaskEvent:
+		set("EVENT_TIMESTAMP", e.Timestamp.Format(time.RFC3339))
+		set("TASK_ID", e.ID)
+		set("TASK_TITLE", e.Title)
+		set("TASK_PROJECT", e.Project)
+		set("TASK_TAGS", strings.Join(e.Tags, ","))
+		set("TASK_ESTIMATED_POMODOROS", strconv.Itoa(e.EstimatedPomodoros))
+		set("TASK_NOTES", e.Notes)
+		set("TASK_STATUS", string(e.Status))
+	}
+
+	return env
+}
@@ -0,0 +1,216 @@
+package hook_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/hook"
+)
+
+var epoch = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func newExecutor(t *testing.T, cfg config.HooksConfig) *hook.Executor {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands need sh")
+	}
+
+	if cfg.Timeout == 0 {
+		cfg.Timeout = config.DefaultHookTimeout
+	}
+
+	if cfg.MaxConcurrency == 0 {
+		cfg.MaxConcurrency = config.DefaultHookMaxConcurrency
+	}
+
+	e, err := hook.New(cfg)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	return e
+}
+
+// waitForLines waits until the file has n lines and returns them.
+func waitForLines(t *testing.T, path string, n int) []string {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		data, _ := os.ReadFile(path)
+		lines := strings.Fields(string(data))
+		if len(lines) >= n {
+			return lines
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("%s has %d lines, want %d", path, len(lines), n)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	e, err := hook.New(config.HooksConfig{
+		Events: config.HookCommands{
+			"task.created":       {"true"},
+			"pomodoro.started":   {"true"},
+			"pomodoro.completed": {},
+		},
+		Timeout:        time.Second,
+		MaxConcurrency: 1,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	want := []event.EventType{event.PomodoroStarted, event.TaskCreated}
+	if got := e.EventTypes(); !slices.Equal(got, want) {
+		t.Errorf("EventTypes() = %v, want %v", got, want)
+	}
+
+	if _, err := hook.New(config.HooksConfig{Events: config.HookCommands{"pomodoro.exploded": {"true"}}}); err == nil {
+		t.Error("New() with an unknown event type returned no error")
+	}
+}
+
+func TestExecutorRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	e := newExecutor(t, config.HooksConfig{Timeout: 200 * time.Millisecond})
+
+	evt := event.PomodoroEvent{
+		BaseEvent:     event.BaseEvent{Type: event.PomodoroCompleted, Timestamp: epoch, Sequence: 3},
+		ID:            "p1",
+		State:         event.PomodoroStateFinished,
+		Phase:         event.PomodoroPhaseShortBreak,
+		PhaseCount:    2,
+		PhaseDuration: 5 * time.Minute,
+		ElapsedTime:   5 * time.Minute,
+		TaskID:        "t1",
+	}
+
+	t.Run("environment and stdin", func(t *testing.T) {
+		t.Parallel()
+
+		out := filepath.Join(t.TempDir(), "out")
+		command := `env | grep ^GOMODORO_ | sort > ` + out + ` && cat >> ` + out
+
+		if code, err := e.Run(ctx, command, evt); err != nil || code != 0 {
+			t.Fatalf("Run() = %d, %v, want 0", code, err)
+		}
+
+		data, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatalf("failed to read output: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		env, stdin := lines[:len(lines)-1], lines[len(lines)-1]
+
+		for _, want := range []string{
+			"GOMODORO_EVENT=pomodoro.completed",
+			"GOMODORO_EVENT_SEQUENCE=3",
+			"GOMODORO_EVENT_TIMESTAMP=2025-01-01T09:00:00Z",
+			"GOMODORO_POMODORO_ID=p1",
+			"GOMODORO_POMODORO_PHASE=short_break",
+			"GOMODORO_POMODORO_PHASE_COUNT=2",
+			"GOMODORO_POMODORO_PHASE_DURATION_SEC=300",
+			"GOMODORO_POMODORO_ELAPSED_SEC=300",
+			"GOMODORO_POMODORO_REMAINING_SEC=0",
+			"GOMODORO_TASK_ID=t1",
+		} {
+			if !slices.Contains(env, want) {
+				t.Errorf("environment %v does not contain %s", env, want)
+			}
+		}
+
+		var got event.PomodoroEvent
+		if err := json.Unmarshal([]byte(stdin), &got); err != nil || got.ID != "p1" || got.Type != event.PomodoroCompleted {
+			t.Errorf("stdin = %s, want the event as JSON", stdin)
+		}
+	})
+
+	t.Run("exit code", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := e.Run(ctx, "echo oops >&2; exit 3", evt)
+		if err == nil || code != 3 {
+			t.Fatalf("Run() = %d, %v, want 3 and an error", code, err)
+		}
+
+		if !strings.Contains(err.Error(), "oops") {
+			t.Errorf("error %q does not contain stderr", err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+
+		if _, err := e.Run(ctx, "sleep 10", evt); err == nil {
+			t.Fatal("Run() returned no error")
+		}
+
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Run() took %v, want it to be killed after the timeout", elapsed)
+		}
+	})
+}
+
+func TestExecutorStart(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+
+	// With a single slot, a command that finds the lock of another one records an overlap.
+	command := `mkdir ` + dir + `/lock || echo overlap >> ` + out + `; sleep 0.05; rmdir ` + dir + `/lock; ` +
+		`echo "$GOMODORO_EVENT:$GOMODORO_TASK_TITLE" >> ` + out
+
+	e := newExecutor(t, config.HooksConfig{
+		Events:         config.HookCommands{"task.created": {command, command}},
+		MaxConcurrency: 1,
+	})
+
+	ctx := context.Background()
+	bus := event.NewInMemoryBus()
+	e.Start(ctx, bus)
+
+	bus.Publish(event.TaskEvent{BaseEvent: event.BaseEvent{Type: event.TaskUpdated, Timestamp: epoch}, Title: "skipped"})
+	bus.Publish(event.TaskEvent{BaseEvent: event.BaseEvent{Type: event.TaskCreated, Timestamp: epoch}, Title: "a"})
+	bus.Publish(event.TaskEvent{BaseEvent: event.BaseEvent{Type: event.TaskCreated, Timestamp: epoch}, Title: "b"})
+
+	lines := waitForLines(t, out, 4)
+
+	stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := e.Stop(stopCtx); err != nil {
+		t.Fatalf("Stop() returned error: %v", err)
+	}
+
+	want := []string{"task.created:a", "task.created:a", "task.created:b", "task.created:b"}
+	if !slices.Equal(lines, want) {
+		t.Errorf("hooks wrote %v, want %v", lines, want)
+	}
+
+	if subscribers := bus.Stats().Subscribers; subscribers != 0 {
+		t.Errorf("bus has %d subscribers after Stop(), want 0", subscribers)
+	}
+}
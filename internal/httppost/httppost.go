@@ -0,0 +1,98 @@
+// Package httppost posts payloads to HTTP endpoints, e.g. of webhooks and notification services
+package httppost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+)
+
+// maxResponseBodySize is how much of a failed response body is kept in the error.
+const maxResponseBodySize = 512
+
+// Doer sends HTTP requests, e.g. *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// StatusError is returned when an endpoint responds with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// Retryable reports whether a later attempt may succeed.
+// Server errors and rate limiting are retried, other client errors are not.
+func (e *StatusError) Retryable() bool {
+	return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+}
+
+// Post sends a body with the header to a URL and returns a *StatusError unless the response status is 2xx.
+func Post(ctx context.Context, client Doer, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header = header
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBodySize))
+		return &StatusError{StatusCode: res.StatusCode, Body: strings.TrimSpace(string(resBody))}
+	}
+
+	return nil
+}
+
+// ParseTemplate parses a template of a JSON payload.
+// The template can embed a value as JSON with the json function, e.g. {"text": {{ json .Title }}}.
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(template.FuncMap{"json": toJSON}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// RenderJSON renders a template of a JSON payload with data.
+// The payload is compacted, so it can be stored and resent byte for byte.
+func RenderJSON(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render payload: %w", err)
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("payload template did not render JSON: %w", err)
+	}
+
+	return compacted.Bytes(), nil
+}
+
+func toJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,76 @@
+package httppost_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/httppost"
+)
+
+func TestPost(t *testing.T) {
+	t.Parallel()
+
+	var got string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = r.Header.Get("X-Test") + " " + string(body)
+
+		if r.Header.Get("X-Test") == "fail" {
+			http.Error(w, "  slow down  ", http.StatusTooManyRequests)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+
+	if err := httppost.Post(ctx, srv.Client(), srv.URL, http.Header{"X-Test": {"ok"}}, []byte("hello")); err != nil {
+		t.Fatalf("Post() returned error: %v", err)
+	}
+
+	if got != "ok hello" {
+		t.Errorf("server received %q, want %q", got, "ok hello")
+	}
+
+	err := httppost.Post(ctx, srv.Client(), srv.URL, http.Header{"X-Test": {"fail"}}, nil)
+
+	var statusErr *httppost.StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Post() returned %v, want StatusError", err)
+	}
+
+	if statusErr.StatusCode != http.StatusTooManyRequests || statusErr.Body != "slow down" || !statusErr.Retryable() {
+		t.Errorf("Post() returned %+v, want retryable status 429 with body", statusErr)
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := httppost.ParseTemplate("test", "{\n  \"text\": {{ json .Title }}\n}")
+	if err != nil {
+		t.Fatalf("ParseTemplate() returned error: %v", err)
+	}
+
+	got, err := httppost.RenderJSON(tmpl, struct{ Title string }{`say "hi"`})
+	if err != nil {
+		t.Fatalf("RenderJSON() returned error: %v", err)
+	}
+
+	if want := `{"text":"say \"hi\""}`; string(got) != want {
+		t.Errorf("RenderJSON() = %s, want %s", got, want)
+	}
+
+	tmpl, err = httppost.ParseTemplate("test", "text: {{ .Title }}")
+	if err != nil {
+		t.Fatalf("ParseTemplate() returned error: %v", err)
+	}
+
+	if _, err := httppost.RenderJSON(tmpl, struct{ Title string }{"hi"}); err == nil {
+		t.Error("RenderJSON() of a template that is not JSON succeeded, want error")
+	}
+}
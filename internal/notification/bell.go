@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Bell rings the terminal bell.
+type Bell struct {
+	w io.Writer
+}
+
+// NewBell creates a new Bell that writes the bell character to w.
+func NewBell(w io.Writer) *Bell {
+	return &Bell{w: w}
+}
+
+// Notify rings the bell. The message is not shown.
+func (b *Bell) Notify(context.Context, Message) error {
+	if _, err := io.WriteString(b.w, "\a"); err != nil {
+		return fmt.Errorf("failed to ring the bell: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatappi/gomodoro/internal/notify"
+)
+
+const (
+	desktopIcon = "appointment-soon"
+	// desktopReplaceKey makes each notification of the server replace the previous one.
+	desktopReplaceKey = "server"
+)
+
+// Desktop shows notifications on the desktop of the server.
+type Desktop struct {
+	notifier *notify.Notifier
+}
+
+// NewDesktop creates a new Desktop.
+func NewDesktop() *Desktop {
+	return &Desktop{notifier: notify.New()}
+}
+
+// Notify shows a desktop notification.
+func (d *Desktop) Notify(ctx context.Context, msg Message) error {
+	err := d.notifier.Notify(ctx, notify.Notification{
+		Title:      msg.Title,
+		Message:    msg.Body,
+		Icon:       desktopIcon,
+		ReplaceKey: desktopReplaceKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to show desktop notification: %w", err)
+	}
+
+	return nil
+}
+
+// Close releases the connection to the notification server.
+func (d *Desktop) Close() error {
+	return d.notifier.Close()
+}
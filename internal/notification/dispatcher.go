@@ -0,0 +1,242 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+)
+
+// TaskFinder finds the task of a session for the templates.
+type TaskFinder interface {
+	GetTaskByID(id string) (*core.Task, error)
+}
+
+// Dispatcher notifies the channels of a phase when the phase ends.
+type Dispatcher struct {
+	channels []channel
+	phases   map[event.PomodoroPhase]*phase
+	timeout  time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new Dispatcher from the configuration.
+func New(cfg config.NotificationsConfig, opts ...Option) (*Dispatcher, error) {
+	o := &options{
+		httpClient: &http.Client{},
+		bell:       os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	d := &Dispatcher{
+		phases:  make(map[event.PomodoroPhase]*phase),
+		timeout: cfg.Timeout,
+	}
+
+	if d.timeout == 0 {
+		d.timeout = config.DefaultNotificationTimeout
+	}
+
+	for _, channelCfg := range cfg.Channels {
+		if slices.ContainsFunc(d.channels, func(c channel) bool { return c.name == channelCfg.Name }) {
+			return nil, fmt.Errorf("duplicate notification channel name %q", channelCfg.Name)
+		}
+
+		notifier, err := newChannel(channelCfg, o)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notification channel %q: %w", channelCfg.Name, err)
+		}
+
+		d.channels = append(d.channels, channel{name: channelCfg.Name, notifier: notifier})
+	}
+
+	d.channels = append(d.channels, o.channels...)
+
+	phases := []struct {
+		phase event.PomodoroPhase
+		kind  string
+		cfg   config.NotificationPhaseConfig
+	}{
+		{event.PomodoroPhaseWork, "work", cfg.Phases.Work},
+		{event.PomodoroPhaseShortBreak, "break", cfg.Phases.ShortBreak},
+		{event.PomodoroPhaseLongBreak, "break", cfg.Phases.LongBreak},
+	}
+
+	for _, p := range phases {
+		rule, err := newPhase(string(p.phase), p.kind, p.cfg, d.channels)
+		if err != nil {
+			return nil, err
+		}
+
+		d.phases[p.phase] = rule
+	}
+
+	return d, nil
+}
+
+// Enabled reports whether any channel is configured.
+func (d *Dispatcher) Enabled() bool {
+	return len(d.channels) > 0
+}
+
+// Start subscribes to the end of phases on the bus and notifies until ctx is done or Stop is called.
+// tasks finds the task of a session, so that the templates can use its title.
+func (d *Dispatcher) Start(ctx context.Context, bus event.EventBus, tasks TaskFinder) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	if !d.Enabled() {
+		return
+	}
+
+	busCh, unsubscribe := bus.SubscribeChannel(
+		[]event.EventType{event.PomodoroCompleted, event.PomodoroStopped},
+		event.WithSubscriberName("notifications"),
+	)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-busCh:
+				if !ok {
+					return
+				}
+
+				evt, ok := e.(event.PomodoroEvent)
+				if !ok {
+					continue
+				}
+
+				task := d.findTask(ctx, tasks, evt.TaskID)
+
+				// Sending may take until the timeout, so it does not hold up the next event.
+				d.wg.Add(1)
+				go func() {
+					defer d.wg.Done()
+
+					if err := d.Notify(context.WithoutCancel(ctx), evt, task); err != nil {
+						log.FromContext(ctx).Error(err, "Failed to send notification", "phase", evt.Phase)
+					}
+				}()
+			}
+		}
+	}()
+}
+
+// Stop stops receiving events, waits until the pending notifications are sent or ctx is done,
+// and closes the channels.
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel != nil {
+		d.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for pending notifications: %w", ctx.Err())
+	}
+
+	var errs []error
+
+	for _, c := range d.channels {
+		if closer, ok := c.notifier.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to close notification channel %q: %w", c.name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Notify sends the notification of the phase that ended with the event to the channels of the phase.
+// The channels are notified concurrently, each within the timeout. task may be nil.
+// A stopped phase is notified only if the phase is configured to.
+func (d *Dispatcher) Notify(ctx context.Context, evt event.PomodoroEvent, task *core.Task) error {
+	p, ok := d.phases[evt.Phase]
+	if !ok {
+		return fmt.Errorf("unknown phase %q", evt.Phase)
+	}
+
+	stopped := evt.Type == event.PomodoroStopped
+	if stopped && !p.onStop {
+		return nil
+	}
+
+	data := Data{Phase: evt.Phase, Stopped: stopped, Event: evt}
+	if task != nil {
+		data.Task = *task
+	}
+
+	msg, err := p.render(data)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, len(p.channels))
+
+	var wg sync.WaitGroup
+
+	for i, c := range p.channels {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sendCtx, cancel := context.WithTimeout(ctx, d.timeout)
+			defer cancel()
+
+			if err := c.notifier.Notify(sendCtx, msg); err != nil {
+				errs[i] = fmt.Errorf("channel %q: %w", c.name, err)
+				return
+			}
+
+			log.FromContext(ctx).V(1).Info("Notification sent", "channel", c.name, "phase", evt.Phase)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// findTask returns the task of a session, or nil if the session has none or it cannot be found.
+func (d *Dispatcher) findTask(ctx context.Context, tasks TaskFinder, id string) *core.Task {
+	if tasks == nil || id == "" {
+		return nil
+	}
+
+	task, err := tasks.GetTaskByID(id)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to get task of notification", "taskID", id)
+		return nil
+	}
+
+	return task
+}
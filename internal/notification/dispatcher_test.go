@@ -0,0 +1,255 @@
+package notification_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/notification"
+)
+
+// recorder is a Notifier that records the messages it is sent.
+type recorder struct {
+	mu       sync.Mutex
+	err      error
+	messages []notification.Message
+	received chan struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{received: make(chan struct{}, 10)}
+}
+
+func (r *recorder) Notify(_ context.Context, msg notification.Message) error {
+	r.mu.Lock()
+	r.messages = append(r.messages, msg)
+	r.mu.Unlock()
+
+	r.received <- struct{}{}
+
+	return r.err
+}
+
+func (r *recorder) Messages() []notification.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]notification.Message(nil), r.messages...)
+}
+
+type taskFinder map[string]*core.Task
+
+func (f taskFinder) GetTaskByID(id string) (*core.Task, error) {
+	if task, ok := f[id]; ok {
+		return task, nil
+	}
+
+	return nil, errors.New("not found")
+}
+
+func phaseEvent(eventType event.EventType, phase event.PomodoroPhase) event.PomodoroEvent {
+	return event.PomodoroEvent{
+		BaseEvent: event.BaseEvent{Type: eventType},
+		ID:        "p1",
+		TaskID:    "t1",
+		Phase:     phase,
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := map[string]struct {
+		cfg     config.NotificationsConfig
+		wantErr string
+	}{
+		"valid": {
+			cfg: config.NotificationsConfig{
+				Channels: []config.NotificationChannelConfig{
+					{Name: "bell", Type: config.NotificationChannelBell},
+					{Name: "phone", Type: config.NotificationChannelNtfy, URL: "https://ntfy.sh/topic"},
+				},
+				Phases: config.NotificationPhasesConfig{
+					Work: config.NotificationPhaseConfig{Channels: []string{"phone"}, Title: "{{ .Task.Title }}"},
+				},
+			},
+		},
+		"duplicate channel": {
+			cfg: config.NotificationsConfig{
+				Channels: []config.NotificationChannelConfig{
+					{Name: "bell", Type: config.NotificationChannelBell},
+					{Name: "bell", Type: config.NotificationChannelBell},
+				},
+			},
+			wantErr: `duplicate notification channel name "bell"`,
+		},
+		"missing url": {
+			cfg: config.NotificationsConfig{
+				Channels: []config.NotificationChannelConfig{{Name: "hook", Type: config.NotificationChannelWebhook}},
+			},
+			wantErr: `invalid notification channel "hook": url is required`,
+		},
+		"missing gotify token": {
+			cfg: config.NotificationsConfig{
+				Channels: []config.NotificationChannelConfig{
+					{Name: "gotify", Type: config.NotificationChannelGotify, URL: "https://gotify.example.com"},
+				},
+			},
+			wantErr: `invalid notification channel "gotify": url and token are required`,
+		},
+		"unknown channel in phase": {
+			cfg: config.NotificationsConfig{
+				Phases: config.NotificationPhasesConfig{
+					LongBreak: config.NotificationPhaseConfig{Channels: []string{"phone"}},
+				},
+			},
+			wantErr: `unknown channel "phone" in phase long_break`,
+		},
+		"invalid template": {
+			cfg: config.NotificationsConfig{
+				Phases: config.NotificationPhasesConfig{
+					ShortBreak: config.NotificationPhaseConfig{Message: "{{ .Task.Title"},
+				},
+			},
+			wantErr: "failed to parse message of phase short_break",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := notification.New(tt.cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("New() returned error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("New() returned %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDispatcherNotify(t *testing.T) {
+	ctx := context.Background()
+	task := &core.Task{ID: "t1", Title: "write docs"}
+
+	desk, phone := newRecorder(), newRecorder()
+
+	d, err := notification.New(
+		config.NotificationsConfig{
+			Phases: config.NotificationPhasesConfig{
+				Work: config.NotificationPhaseConfig{
+					Channels: []string{"phone"},
+					Title:    "Done: {{ .Task.Title }}",
+					Message:  "{{ .Event.ID }} {{ if .Stopped }}stopped{{ else }}completed{{ end }}",
+					OnStop:   true,
+				},
+			},
+		},
+		notification.WithChannel("desk", desk),
+		notification.WithChannel("phone", phone),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if err := d.Notify(ctx, phaseEvent(event.PomodoroCompleted, event.PomodoroPhaseWork), task); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	if err := d.Notify(ctx, phaseEvent(event.PomodoroStopped, event.PomodoroPhaseWork), task); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	// The break phases use the default templates and notify every channel, but not when stopped.
+	if err := d.Notify(ctx, phaseEvent(event.PomodoroCompleted, event.PomodoroPhaseShortBreak), nil); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	if err := d.Notify(ctx, phaseEvent(event.PomodoroStopped, event.PomodoroPhaseLongBreak), task); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	got := phone.Messages()
+	want := [][2]string{
+		{"Done: write docs", "p1 completed"},
+		{"Done: write docs", "p1 stopped"},
+		{"gomodoro", "Finish break time"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("phone received %d messages, want %d", len(got), len(want))
+	}
+
+	for i, msg := range got {
+		if msg.Title != want[i][0] || msg.Body != want[i][1] {
+			t.Errorf("message %d = %q %q, want %q %q", i, msg.Title, msg.Body, want[i][0], want[i][1])
+		}
+	}
+
+	if got := desk.Messages(); len(got) != 1 || got[0].Phase != event.PomodoroPhaseShortBreak {
+		t.Errorf("desk received %+v, want the short break", got)
+	}
+
+	desk.err = errors.New("no display")
+
+	err = d.Notify(ctx, phaseEvent(event.PomodoroCompleted, event.PomodoroPhaseLongBreak), task)
+	if err == nil || !strings.Contains(err.Error(), `channel "desk": no display`) {
+		t.Errorf("Notify() returned %v, want the error of desk", err)
+	}
+
+	if got := phone.Messages(); got[len(got)-1].Body != "write docs:Finish break time" {
+		t.Errorf("phone was not notified when desk failed, got %+v", got[len(got)-1])
+	}
+}
+
+func TestDispatcherStart(t *testing.T) {
+	var bell bytes.Buffer
+
+	rec := newRecorder()
+
+	d, err := notification.New(
+		config.NotificationsConfig{
+			Channels: []config.NotificationChannelConfig{{Name: "bell", Type: config.NotificationChannelBell}},
+			Timeout:  time.Second,
+		},
+		notification.WithBellWriter(&bell),
+		notification.WithChannel("recorder", rec),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	bus := event.NewInMemoryBus()
+	d.Start(context.Background(), bus, taskFinder{"t1": {ID: "t1", Title: "write docs"}})
+
+	bus.Publish(phaseEvent(event.PomodoroStarted, event.PomodoroPhaseWork))
+	bus.Publish(phaseEvent(event.PomodoroCompleted, event.PomodoroPhaseWork))
+
+	select {
+	case <-rec.received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+
+	if err := d.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() returned error: %v", err)
+	}
+
+	if got := rec.Messages(); len(got) != 1 || got[0].Body != "write docs:Finish work time" {
+		t.Errorf("recorder received %+v, want one message of the completed work", got)
+	}
+
+	if bell.String() != "\a" {
+		t.Errorf("bell wrote %q, want \\a", bell.String())
+	}
+}
@@ -0,0 +1,167 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/httppost"
+)
+
+// ntfyTags is shown by ntfy as an emoji before the title.
+const ntfyTags = "tomato"
+
+// WebhookPayload is the JSON posted by Webhook without a template.
+type WebhookPayload struct {
+	Title   string              `json:"title"`
+	Message string              `json:"message"`
+	Phase   event.PomodoroPhase `json:"phase"`
+	Stopped bool                `json:"stopped"`
+	Task    *core.Task          `json:"task,omitempty"`
+	Event   event.PomodoroEvent `json:"event"`
+}
+
+// Webhook posts notifications as JSON to a URL.
+// Unlike the webhooks of internal/webhook, a notification is not signed, retried or dead-lettered:
+// it is only of use while the phase it announces is current, and a failure is logged.
+// Configure a webhook to receive the events themselves with delivery guarantees.
+type Webhook struct {
+	client   *http.Client
+	url      string
+	token    string
+	template *template.Template
+}
+
+// NewWebhook creates a new Webhook. The token is sent as a bearer token when it is set.
+// tmpl is a Go template of the JSON payload that is passed the Message, e.g. {"text": {{ json .Body }}}.
+func NewWebhook(client *http.Client, url, token, tmpl string) (*Webhook, error) {
+	w := &Webhook{client: client, url: url, token: token}
+
+	if tmpl != "" {
+		t, err := httppost.ParseTemplate(url, tmpl)
+		if err != nil {
+			return nil, err
+		}
+
+		w.template = t
+	}
+
+	return w, nil
+}
+
+// Notify posts the notification.
+func (w *Webhook) Notify(ctx context.Context, msg Message) error {
+	body, err := w.render(msg)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{"Content-Type": {"application/json"}}
+	if w.token != "" {
+		header.Set("Authorization", "Bearer "+w.token)
+	}
+
+	return httppost.Post(ctx, w.client, w.url, header, body)
+}
+
+func (w *Webhook) render(msg Message) ([]byte, error) {
+	if w.template == nil {
+		payload := WebhookPayload{
+			Title:   msg.Title,
+			Message: msg.Body,
+			Phase:   msg.Phase,
+			Stopped: msg.Stopped,
+			Event:   msg.Event,
+		}
+
+		if msg.Task.ID != "" {
+			payload.Task = &msg.Task
+		}
+
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		return data, nil
+	}
+
+	return httppost.RenderJSON(w.template, msg)
+}
+
+// Ntfy publishes notifications to an ntfy topic, see https://docs.ntfy.sh/publish/.
+type Ntfy struct {
+	client   *http.Client
+	url      string
+	token    string
+	priority int
+}
+
+// NewNtfy creates a new Ntfy that publishes to the topic URL, e.g. https://ntfy.sh/my-topic.
+// The token is sent as a bearer token when it is set, and a priority of 0 leaves it to the server.
+func NewNtfy(client *http.Client, url, token string, priority int) *Ntfy {
+	return &Ntfy{client: client, url: url, token: token, priority: priority}
+}
+
+// Notify publishes the notification.
+func (n *Ntfy) Notify(ctx context.Context, msg Message) error {
+	header := http.Header{
+		"Content-Type": {"text/plain; charset=utf-8"},
+		// Non-ASCII titles, e.g. of tasks, are encoded as ntfy decodes RFC 2047 headers.
+		"Title": {mime.BEncoding.Encode("utf-8", msg.Title)},
+		"Tags":  {ntfyTags},
+	}
+
+	if n.priority > 0 {
+		header.Set("Priority", strconv.Itoa(n.priority))
+	}
+
+	if n.token != "" {
+		header.Set("Authorization", "Bearer "+n.token)
+	}
+
+	return httppost.Post(ctx, n.client, n.url, header, []byte(msg.Body))
+}
+
+// Gotify pushes notifications to a Gotify server, see https://gotify.net/docs/pushmsg.
+type Gotify struct {
+	client   *http.Client
+	url      string
+	token    string
+	priority int
+}
+
+// gotifyMessage is the body of the message endpoint of Gotify.
+type gotifyMessage struct {
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority,omitempty"`
+}
+
+// NewGotify creates a new Gotify that pushes to the server URL with the token of an application.
+// A priority of 0 leaves it to the application.
+func NewGotify(client *http.Client, url, token string, priority int) *Gotify {
+	return &Gotify{client: client, url: strings.TrimSuffix(url, "/"), token: token, priority: priority}
+}
+
+// Notify pushes the notification.
+func (g *Gotify) Notify(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(gotifyMessage{Title: msg.Title, Message: msg.Body, Priority: g.priority})
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	header := http.Header{
+		"Content-Type": {"application/json"},
+		"X-Gotify-Key": {g.token},
+	}
+
+	return httppost.Post(ctx, g.client, g.url+"/message", header, body)
+}
@@ -0,0 +1,202 @@
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/notification"
+)
+
+type request struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a local stand-in for a webhook, ntfy or Gotify server that answers with status.
+func newReceiver(t *testing.T, status int) (*httptest.Server, <-chan request) {
+	t.Helper()
+
+	requests := make(chan request, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests <- request{path: req.URL.Path, header: req.Header.Clone(), body: body}
+
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, "receiver says hello\n")
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func receive(t *testing.T, requests <-chan request) request {
+	t.Helper()
+
+	select {
+	case r := <-requests:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for request")
+	}
+
+	return request{}
+}
+
+func testMessage() notification.Message {
+	return notification.Message{
+		Title: "gomodoro",
+		Body:  "write docs:Finish work time",
+		Data: notification.Data{
+			Phase: event.PomodoroPhaseWork,
+			Task:  core.Task{ID: "t1", Title: "write docs"},
+			Event: event.PomodoroEvent{
+				BaseEvent: event.BaseEvent{Type: event.PomodoroCompleted},
+				ID:        "p1",
+				TaskID:    "t1",
+				Phase:     event.PomodoroPhaseWork,
+			},
+		},
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default payload", func(t *testing.T) {
+		server, requests := newReceiver(t, http.StatusNoContent)
+
+		w, err := notification.NewWebhook(server.Client(), server.URL+"/hook", "secret", "")
+		if err != nil {
+			t.Fatalf("NewWebhook() returned error: %v", err)
+		}
+
+		if err := w.Notify(ctx, testMessage()); err != nil {
+			t.Fatalf("Notify() returned error: %v", err)
+		}
+
+		r := receive(t, requests)
+		if got := r.header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q, want Bearer secret", got)
+		}
+
+		var payload notification.WebhookPayload
+		if err := json.Unmarshal(r.body, &payload); err != nil {
+			t.Fatalf("payload is not JSON: %v", err)
+		}
+
+		if payload.Message != "write docs:Finish work time" || payload.Phase != event.PomodoroPhaseWork ||
+			payload.Task == nil || payload.Task.Title != "write docs" || payload.Event.ID != "p1" {
+			t.Errorf("payload = %s", r.body)
+		}
+	})
+
+	t.Run("template", func(t *testing.T) {
+		server, requests := newReceiver(t, http.StatusOK)
+
+		w, err := notification.NewWebhook(server.Client(), server.URL, "", `{"text": {{ json .Body }}}`)
+		if err != nil {
+			t.Fatalf("NewWebhook() returned error: %v", err)
+		}
+
+		if err := w.Notify(ctx, testMessage()); err != nil {
+			t.Fatalf("Notify() returned error: %v", err)
+		}
+
+		r := receive(t, requests)
+		if got, want := string(r.body), `{"text":"write docs:Finish work time"}`; got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+
+		if got := r.header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization = %q, want none", got)
+		}
+	})
+
+	t.Run("template that does not render JSON", func(t *testing.T) {
+		w, err := notification.NewWebhook(http.DefaultClient, "http://localhost:1", "", `{{ .Body }}`)
+		if err != nil {
+			t.Fatalf("NewWebhook() returned error: %v", err)
+		}
+
+		if err := w.Notify(ctx, testMessage()); err == nil {
+			t.Error("Notify() succeeded, want error")
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		server, _ := newReceiver(t, http.StatusBadRequest)
+
+		w, err := notification.NewWebhook(server.Client(), server.URL, "", "")
+		if err != nil {
+			t.Fatalf("NewWebhook() returned error: %v", err)
+		}
+
+		err = w.Notify(ctx, testMessage())
+		if err == nil || err.Error() != "unexpected status 400: receiver says hello" {
+			t.Errorf("Notify() returned %v, want unexpected status", err)
+		}
+	})
+}
+
+func TestNtfy(t *testing.T) {
+	server, requests := newReceiver(t, http.StatusOK)
+
+	msg := testMessage()
+	msg.Title = "ポモドーロ"
+
+	n := notification.NewNtfy(server.Client(), server.URL+"/my-topic", "tk_token", 4)
+	if err := n.Notify(context.Background(), msg); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	r := receive(t, requests)
+	if r.path != "/my-topic" {
+		t.Errorf("path = %q, want /my-topic", r.path)
+	}
+
+	if string(r.body) != msg.Body {
+		t.Errorf("body = %q, want %q", r.body, msg.Body)
+	}
+
+	wantHeader := map[string]string{
+		"Title":         "=?utf-8?b?44Od44Oi44OJ44O844Ot?=",
+		"Priority":      "4",
+		"Tags":          "tomato",
+		"Authorization": "Bearer tk_token",
+	}
+	for key, want := range wantHeader {
+		if got := r.header.Get(key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestGotify(t *testing.T) {
+	server, requests := newReceiver(t, http.StatusOK)
+
+	g := notification.NewGotify(server.Client(), server.URL+"/", "app-token", 0)
+	if err := g.Notify(context.Background(), testMessage()); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	r := receive(t, requests)
+	if r.path != "/message" {
+		t.Errorf("path = %q, want /message", r.path)
+	}
+
+	if got := r.header.Get("X-Gotify-Key"); got != "app-token" {
+		t.Errorf("X-Gotify-Key = %q, want app-token", got)
+	}
+
+	if got, want := string(r.body), `{"title":"gomodoro","message":"write docs:Finish work time"}`; got != want {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+}
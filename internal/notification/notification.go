@@ -0,0 +1,176 @@
+// Package notification notifies channels such as the desktop or a phone when a pomodoro phase ends
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"text/template"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+)
+
+const (
+	// DefaultTitle is the title template of a phase without one.
+	DefaultTitle = "gomodoro"
+	// defaultMessageFormat is the message template of a phase without one, e.g. "write docs:Finish work time".
+	defaultMessageFormat = "{{ with .Task.Title }}{{ . }}:{{ end }}" +
+		"{{ if .Stopped }}Stopped{{ else }}Finish{{ end }} %s time"
+)
+
+// Notifier sends notifications to a channel.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Data is passed to the title and message templates.
+type Data struct {
+	// Phase is the phase that ended.
+	Phase event.PomodoroPhase
+	// Stopped is true when the phase was stopped before it ended.
+	Stopped bool
+	// Task is the task of the session. Its fields are empty for a session without a task.
+	Task core.Task
+	// Event is the pomodoro.completed or pomodoro.stopped event.
+	Event event.PomodoroEvent
+}
+
+// Message is a rendered notification.
+type Message struct {
+	Title string
+	Body  string
+	Data
+}
+
+// channel is a configured Notifier.
+type channel struct {
+	name     string
+	notifier Notifier
+}
+
+// phase is the notification sent when a phase ends.
+type phase struct {
+	channels []channel
+	title    *template.Template
+	message  *template.Template
+	onStop   bool
+}
+
+// options are the dependencies of the channels, replaced in tests.
+type options struct {
+	httpClient *http.Client
+	bell       io.Writer
+	channels   []channel
+}
+
+// Option represents a function that configures the Dispatcher.
+type Option func(*options)
+
+// WithHTTPClient sets the HTTP client of the webhook, ntfy and Gotify channels.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithBellWriter sets where the bell channel writes the bell character instead of stdout.
+func WithBellWriter(w io.Writer) Option {
+	return func(o *options) {
+		o.bell = w
+	}
+}
+
+// WithChannel adds a channel that is not in the configuration, e.g. for testing.
+func WithChannel(name string, notifier Notifier) Option {
+	return func(o *options) {
+		o.channels = append(o.channels, channel{name: name, notifier: notifier})
+	}
+}
+
+func newChannel(cfg config.NotificationChannelConfig, o *options) (Notifier, error) { //nolint:ireturn
+	switch cfg.Type {
+	case config.NotificationChannelDesktop:
+		return NewDesktop(), nil
+	case config.NotificationChannelBell:
+		return NewBell(o.bell), nil
+	case config.NotificationChannelWebhook:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("url is required")
+		}
+
+		return NewWebhook(o.httpClient, cfg.URL, cfg.Token, cfg.Template)
+	case config.NotificationChannelNtfy:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("url is required")
+		}
+
+		return NewNtfy(o.httpClient, cfg.URL, cfg.Token, cfg.Priority), nil
+	case config.NotificationChannelGotify:
+		if cfg.URL == "" || cfg.Token == "" {
+			return nil, fmt.Errorf("url and token are required")
+		}
+
+		return NewGotify(o.httpClient, cfg.URL, cfg.Token, cfg.Priority), nil
+	default:
+		return nil, fmt.Errorf("unknown channel type %q", cfg.Type)
+	}
+}
+
+// newPhase creates the notification of a phase. kind is used in the default message, e.g. work.
+func newPhase(name, kind string, cfg config.NotificationPhaseConfig, channels []channel) (*phase, error) {
+	p := &phase{onStop: cfg.OnStop}
+
+	if len(cfg.Channels) == 0 {
+		p.channels = channels
+	}
+
+	for _, channelName := range cfg.Channels {
+		i := slices.IndexFunc(channels, func(c channel) bool { return c.name == channelName })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown channel %q in phase %s", channelName, name)
+		}
+
+		p.channels = append(p.channels, channels[i])
+	}
+
+	title, message := cfg.Title, cfg.Message
+	if title == "" {
+		title = DefaultTitle
+	}
+
+	if message == "" {
+		message = fmt.Sprintf(defaultMessageFormat, kind)
+	}
+
+	var err error
+
+	if p.title, err = template.New(name + " title").Parse(title); err != nil {
+		return nil, fmt.Errorf("failed to parse title of phase %s: %w", name, err)
+	}
+
+	if p.message, err = template.New(name + " message").Parse(message); err != nil {
+		return nil, fmt.Errorf("failed to parse message of phase %s: %w", name, err)
+	}
+
+	return p, nil
+}
+
+// render renders the title and message of the phase.
+func (p *phase) render(data Data) (Message, error) {
+	var title, message bytes.Buffer
+
+	if err := p.title.Execute(&title, data); err != nil {
+		return Message{}, fmt.Errorf("failed to render title: %w", err)
+	}
+
+	if err := p.message.Execute(&message, data); err != nil {
+		return Message{}, fmt.Errorf("failed to render message: %w", err)
+	}
+
+	return Message{Title: title.String(), Body: message.String(), Data: data}, nil
+}
@@ -0,0 +1,120 @@
+// Package notify notification
+package notify
+
+import (
+	"context"
+	"sync"
+)
+
+// AppName is the application name notifications are sent with.
+const AppName = "gomodoro"
+
+// Urgency is the urgency level of a notification.
+type Urgency int
+
+const (
+	// UrgencyNormal is the default urgency.
+	UrgencyNormal Urgency = iota
+	// UrgencyLow is for notifications that can be missed.
+	UrgencyLow
+	// UrgencyCritical is for notifications that stay until they are dismissed.
+	UrgencyCritical
+)
+
+// String returns the name of the urgency, e.g. normal.
+func (u Urgency) String() string {
+	switch u {
+	case UrgencyLow:
+		return "low"
+	case UrgencyCritical:
+		return "critical"
+	default:
+		return "normal"
+	}
+}
+
+// level returns the urgency level of the freedesktop notification specification.
+func (u Urgency) level() byte {
+	switch u {
+	case UrgencyLow:
+		return 0
+	case UrgencyCritical:
+		return 2 //nolint:mnd
+	default:
+		return 1
+	}
+}
+
+// Action is a button of a notification.
+type Action struct {
+	// Key is passed to Notification.OnAction when the button is clicked.
+	Key   string
+	Label string
+}
+
+// Notification is a desktop notification.
+// Urgency, Icon, ReplaceKey, Actions and Silent are ignored where the OS does not support them.
+type Notification struct {
+	Title   string
+	Message string
+	Urgency Urgency
+	// Icon is the name of a freedesktop icon, e.g. appointment-soon, or the path of an image.
+	Icon string
+	// ReplaceKey makes the notification replace the previous one with the same key instead of showing both.
+	ReplaceKey string
+	Actions    []Action
+	// OnAction is called with the key of the clicked action. It is called on its own goroutine.
+	OnAction func(key string)
+	// Silent turns off the sound of the notification, e.g. when the caller plays its own sounds.
+	Silent bool
+}
+
+// backend shows notifications on an OS.
+type backend interface {
+	// notify shows a notification in place of replacesID if it is not 0 and returns its ID, or 0 if it has none.
+	notify(ctx context.Context, n Notification, replacesID uint32) (uint32, error)
+	close() error
+}
+
+// Notifier shows desktop notifications.
+type Notifier struct {
+	backend backend
+
+	mu  sync.Mutex
+	ids map[string]uint32
+}
+
+// New creates a new Notifier for the current OS.
+func New() *Notifier {
+	return &Notifier{
+		backend: newBackend(),
+		ids:     make(map[string]uint32),
+	}
+}
+
+// Notify shows a notification.
+func (n *Notifier) Notify(ctx context.Context, notification Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var replacesID uint32
+	if notification.ReplaceKey != "" {
+		replacesID = n.ids[notification.ReplaceKey]
+	}
+
+	id, err := n.backend.notify(ctx, notification, replacesID)
+	if err != nil {
+		return err
+	}
+
+	if notification.ReplaceKey != "" {
+		n.ids[notification.ReplaceKey] = id
+	}
+
+	return nil
+}
+
+// Close releases the resources of the Notifier. Actions of the notifications shown so far are no longer handled.
+func (n *Notifier) Close() error {
+	return n.backend.close()
+}
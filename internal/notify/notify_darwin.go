@@ -1,21 +1,37 @@
 //go:build darwin
 // +build darwin
 
-// Package notify notification
 package notify
 
 import (
+	"context"
 	"os/exec"
 )
 
-// Notify osx notification using osascript.
-func Notify(title, message string) error {
+type osascriptBackend struct{}
+
+func newBackend() backend {
+	return osascriptBackend{}
+}
+
+// notify shows the notification using osascript, which supports neither replacing nor actions.
+func (osascriptBackend) notify(ctx context.Context, n Notification, _ uint32) (uint32, error) {
 	osa, err := exec.LookPath("osascript")
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	script := `display notification "` + n.Message + `" with title "` + n.Title + `"`
+	if !n.Silent {
+		script += ` sound name "Glass"`
 	}
 
 	//nolint:gosec
-	cmd := exec.Command(osa, "-e", `display notification "`+message+`" with title "`+title+`" sound name "Glass"`)
-	return cmd.Run()
+	cmd := exec.CommandContext(ctx, osa, "-e", script)
+
+	return 0, cmd.Run()
+}
+
+func (osascriptBackend) close() error {
+	return nil
 }
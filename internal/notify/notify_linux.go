@@ -0,0 +1,203 @@
+//go:build linux
+// +build linux
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	dbusDestination = "org.freedesktop.Notifications"
+	dbusPath        = dbus.ObjectPath("/org/freedesktop/Notifications")
+	dbusInterface   = "org.freedesktop.Notifications"
+
+	// signalBufferSize is the number of D-Bus signals queued before the bus drops them.
+	signalBufferSize = 16
+)
+
+// dbusBackend shows notifications with the org.freedesktop.Notifications service on the session bus,
+// and falls back to notify-send when the service cannot be reached.
+type dbusBackend struct {
+	fallback *notifySend
+
+	mu        sync.Mutex
+	conn      *dbus.Conn
+	actions   bool
+	callbacks map[uint32]func(string)
+}
+
+func newBackend() backend {
+	return &dbusBackend{
+		fallback:  newNotifySend(),
+		callbacks: make(map[uint32]func(string)),
+	}
+}
+
+func (b *dbusBackend) notify(ctx context.Context, n Notification, replacesID uint32) (uint32, error) {
+	id, err := b.notifyDBus(ctx, n, replacesID)
+	if err == nil {
+		return id, nil
+	}
+
+	id, fallbackErr := b.fallback.notify(ctx, n, replacesID)
+	if fallbackErr != nil {
+		return 0, fmt.Errorf("%w, and notify-send failed: %w", err, fallbackErr)
+	}
+
+	return id, nil
+}
+
+func (b *dbusBackend) notifyDBus(ctx context.Context, n Notification, replacesID uint32) (uint32, error) {
+	conn, actionsSupported, err := b.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	actions := []string{}
+	if actionsSupported && n.OnAction != nil {
+		for _, a := range n.Actions {
+			actions = append(actions, a.Key, a.Label)
+		}
+	}
+
+	hints := map[string]dbus.Variant{"urgency": dbus.MakeVariant(n.Urgency.level())}
+
+	var id uint32
+
+	err = conn.Object(dbusDestination, dbusPath).CallWithContext(
+		ctx, dbusInterface+".Notify", 0,
+		AppName, replacesID, n.Icon, n.Title, n.Message, actions, hints, int32(-1),
+	).Store(&id)
+	if err != nil {
+		if ctx.Err() == nil {
+			// The connection may be broken, e.g. by a restarted notification server, so reconnect next time.
+			b.disconnect(conn)
+		}
+
+		return 0, fmt.Errorf("failed to send notification over D-Bus: %w", err)
+	}
+
+	b.mu.Lock()
+	if len(actions) > 0 {
+		b.callbacks[id] = n.OnAction
+	} else {
+		delete(b.callbacks, id)
+	}
+	b.mu.Unlock()
+
+	return id, nil
+}
+
+// connect connects to the session bus on first use and reports whether the server shows actions.
+// The connection outlives ctx, which only bounds the calls made while connecting.
+func (b *dbusBackend) connect(ctx context.Context) (*dbus.Conn, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn != nil {
+		return b.conn, b.actions, nil
+	}
+
+	// godbus closes a connection when its context ends, so it must not be the context of a single call.
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to connect to the session bus: %w", err)
+	}
+
+	var capabilities []string
+
+	err = conn.Object(dbusDestination, dbusPath).CallWithContext(ctx, dbusInterface+".GetCapabilities", 0).
+		Store(&capabilities)
+	if err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("failed to get notification server capabilities: %w", err)
+	}
+
+	err = conn.AddMatchSignalContext(ctx, dbus.WithMatchObjectPath(dbusPath), dbus.WithMatchInterface(dbusInterface))
+	if err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("failed to subscribe to notification signals: %w", err)
+	}
+
+	signals := make(chan *dbus.Signal, signalBufferSize)
+	conn.Signal(signals)
+
+	go b.handleSignals(signals)
+
+	b.conn = conn
+	b.actions = slices.Contains(capabilities, "actions")
+
+	return conn, b.actions, nil
+}
+
+// disconnect closes conn and forgets it, unless another call has already replaced it.
+func (b *dbusBackend) disconnect(conn *dbus.Conn) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn != conn {
+		return
+	}
+
+	b.conn = nil
+	_ = conn.Close()
+}
+
+// handleSignals calls the callback of clicked actions until the connection is closed.
+func (b *dbusBackend) handleSignals(signals <-chan *dbus.Signal) {
+	for signal := range signals {
+		if len(signal.Body) < 2 { //nolint:mnd
+			continue
+		}
+
+		id, ok := signal.Body[0].(uint32)
+		if !ok {
+			continue
+		}
+
+		switch signal.Name {
+		case dbusInterface + ".ActionInvoked":
+			key, ok := signal.Body[1].(string)
+			if !ok {
+				continue
+			}
+
+			b.mu.Lock()
+			callback := b.callbacks[id]
+			b.mu.Unlock()
+
+			if callback != nil {
+				go callback(key)
+			}
+		case dbusInterface + ".NotificationClosed":
+			b.mu.Lock()
+			delete(b.callbacks, id)
+			b.mu.Unlock()
+		}
+	}
+}
+
+func (b *dbusBackend) close() error {
+	b.mu.Lock()
+	conn := b.conn
+	b.conn = nil
+	b.mu.Unlock()
+
+	b.fallback.close()
+
+	if conn == nil {
+		return nil
+	}
+
+	if err := conn.Close(); err != nil {
+		return fmt.Errorf("failed to close the session bus connection: %w", err)
+	}
+
+	return nil
+}
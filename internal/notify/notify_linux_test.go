@@ -0,0 +1,351 @@
+//go:build linux
+// +build linux
+
+package notify_test
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+
+	"github.com/hatappi/gomodoro/internal/notify"
+)
+
+const (
+	dbusPath      = dbus.ObjectPath("/org/freedesktop/Notifications")
+	dbusInterface = "org.freedesktop.Notifications"
+)
+
+type notifyCall struct {
+	replacesID uint32
+	icon       string
+	summary    string
+	body       string
+	actions    []string
+	urgency    byte
+}
+
+// notificationServer is a fake org.freedesktop.Notifications service.
+type notificationServer struct {
+	mu     sync.Mutex
+	nextID uint32
+	calls  []notifyCall
+}
+
+func (s *notificationServer) GetCapabilities() ([]string, *dbus.Error) {
+	return []string{"actions", "body"}, nil
+}
+
+func (s *notificationServer) Notify(
+	_ string,
+	replacesID uint32,
+	icon, summary, body string,
+	actions []string,
+	hints map[string]dbus.Variant,
+	_ int32,
+) (uint32, *dbus.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	urgency, _ := hints["urgency"].Value().(byte)
+	s.calls = append(s.calls, notifyCall{replacesID, icon, summary, body, actions, urgency})
+
+	if replacesID != 0 {
+		return replacesID, nil
+	}
+
+	s.nextID++
+
+	return s.nextID, nil
+}
+
+func (s *notificationServer) Calls() []notifyCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.calls)
+}
+
+// startSessionBus starts a private session bus and points DBUS_SESSION_BUS_ADDRESS at it.
+func startSessionBus(t *testing.T) string {
+	t.Helper()
+
+	path, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		t.Skip("dbus-daemon is not installed")
+	}
+
+	cmd := exec.Command(path, "--session", "--nofork", "--print-address")
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		t.Skipf("failed to start dbus-daemon: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	scanner := bufio.NewScanner(stdout)
+	if !scanner.Scan() {
+		t.Skip("dbus-daemon did not print its address")
+	}
+
+	address := strings.TrimSpace(scanner.Text())
+	t.Setenv("DBUS_SESSION_BUS_ADDRESS", address)
+
+	return address
+}
+
+func waitForAction(t *testing.T, actions <-chan string) string {
+	t.Helper()
+
+	select {
+	case key := <-actions:
+		return key
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for action")
+	}
+
+	return ""
+}
+
+// startNotificationServer starts a private session bus with a fake notification server on it.
+func startNotificationServer(t *testing.T) (*dbus.Conn, *notificationServer) {
+	t.Helper()
+
+	address := startSessionBus(t)
+
+	conn, err := dbus.Connect(address)
+	if err != nil {
+		t.Fatalf("failed to connect to the session bus: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	server := &notificationServer{}
+	if err := conn.Export(server, dbusPath, dbusInterface); err != nil {
+		t.Fatalf("failed to export server: %v", err)
+	}
+
+	if _, err := conn.RequestName(dbusInterface, dbus.NameFlagDoNotQueue); err != nil {
+		t.Fatalf("failed to request name: %v", err)
+	}
+
+	return conn, server
+}
+
+func TestNotifierDBus(t *testing.T) {
+	conn, server := startNotificationServer(t)
+
+	ctx := context.Background()
+	n := notify.New()
+	t.Cleanup(func() { _ = n.Close() })
+
+	actions := make(chan string, 1)
+	notification := notify.Notification{
+		Title:      "gomodoro",
+		Message:    "Finish work time",
+		Urgency:    notify.UrgencyCritical,
+		Icon:       "appointment-soon",
+		ReplaceKey: "session",
+		Actions:    []notify.Action{{Key: "start", Label: "Start break"}, {Key: "skip", Label: "Skip"}},
+		OnAction:   func(key string) { actions <- key },
+	}
+
+	if err := n.Notify(ctx, notification); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	if err := n.Notify(ctx, notification); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	if err := n.Notify(ctx, notify.Notification{Title: "gomodoro", Message: "other"}); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	calls := server.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("server received %d notifications, want 3", len(calls))
+	}
+
+	want := notifyCall{
+		replacesID: 0,
+		icon:       "appointment-soon",
+		summary:    "gomodoro",
+		body:       "Finish work time",
+		actions:    []string{"start", "Start break", "skip", "Skip"},
+		urgency:    2,
+	}
+	if got := calls[0]; got.replacesID != want.replacesID || got.icon != want.icon || got.summary != want.summary ||
+		got.body != want.body || !slices.Equal(got.actions, want.actions) || got.urgency != want.urgency {
+		t.Errorf("first notification = %+v, want %+v", got, want)
+	}
+
+	if calls[1].replacesID != 1 {
+		t.Errorf("second notification replaces %d, want 1", calls[1].replacesID)
+	}
+
+	if calls[2].replacesID != 0 || len(calls[2].actions) != 0 || calls[2].urgency != 1 {
+		t.Errorf("notification without replace key = %+v", calls[2])
+	}
+
+	// Actions of other notifications are not ours.
+	if err := conn.Emit(dbusPath, dbusInterface+".ActionInvoked", uint32(2), "start"); err != nil {
+		t.Fatalf("failed to emit signal: %v", err)
+	}
+
+	if err := conn.Emit(dbusPath, dbusInterface+".ActionInvoked", uint32(1), "skip"); err != nil {
+		t.Fatalf("failed to emit signal: %v", err)
+	}
+
+	if key := waitForAction(t, actions); key != "skip" {
+		t.Errorf("OnAction() called with %q, want skip", key)
+	}
+}
+
+func TestNotifierDBusCancelledContext(t *testing.T) {
+	_, server := startNotificationServer(t)
+
+	// Without notify-send, a notification can only be shown over D-Bus.
+	t.Setenv("PATH", t.TempDir())
+
+	n := notify.New()
+	t.Cleanup(func() { _ = n.Close() })
+
+	notification := notify.Notification{Title: "gomodoro", Message: "Finish work time", ReplaceKey: "session"}
+
+	for range 2 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := n.Notify(ctx, notification)
+		cancel()
+
+		if err != nil {
+			t.Fatalf("Notify() returned error: %v", err)
+		}
+	}
+
+	calls := server.Calls()
+	if len(calls) != 2 {
+		t.Fatalf("server received %d notifications, want 2", len(calls))
+	}
+
+	if calls[1].replacesID != 1 {
+		t.Errorf("second notification replaces %d, want 1", calls[1].replacesID)
+	}
+}
+
+func TestNotifierDBusReconnect(t *testing.T) {
+	conn, server := startNotificationServer(t)
+	t.Setenv("PATH", t.TempDir())
+
+	ctx := context.Background()
+	n := notify.New()
+	t.Cleanup(func() { _ = n.Close() })
+
+	notification := notify.Notification{Title: "gomodoro", Message: "Finish work time"}
+
+	if err := n.Notify(ctx, notification); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	// A notification server that goes away fails the next call, and a new one is used after it.
+	if _, err := conn.ReleaseName(dbusInterface); err != nil {
+		t.Fatalf("failed to release name: %v", err)
+	}
+
+	if err := n.Notify(ctx, notification); err == nil {
+		t.Fatal("Notify() succeeded without a notification server, want error")
+	}
+
+	if _, err := conn.RequestName(dbusInterface, dbus.NameFlagDoNotQueue); err != nil {
+		t.Fatalf("failed to request name: %v", err)
+	}
+
+	if err := n.Notify(ctx, notification); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	if calls := server.Calls(); len(calls) != 2 {
+		t.Errorf("server received %d notifications, want 2", len(calls))
+	}
+}
+
+func TestNotifierNotifySend(t *testing.T) {
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+
+	// The fake notify-send records its arguments, prints an ID and, when waiting, the first action.
+	script := `#!/bin/sh
+printf '%s\n' "$@" > ` + argsFile + `.tmp && mv ` + argsFile + `.tmp ` + argsFile + `
+echo 7
+for arg in "$@"; do
+  case "$arg" in
+    --wait) echo skip ;;
+  esac
+done
+`
+	if err := os.WriteFile(filepath.Join(dir, "notify-send"), []byte(script), 0o700); err != nil { //nolint:gosec
+		t.Fatalf("failed to write fake notify-send: %v", err)
+	}
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("DBUS_SESSION_BUS_ADDRESS", "unix:path="+filepath.Join(dir, "missing"))
+
+	ctx := context.Background()
+	n := notify.New()
+	t.Cleanup(func() { _ = n.Close() })
+
+	if err := n.Notify(ctx, notify.Notification{
+		Title:      "gomodoro",
+		Message:    "Finish break time",
+		ReplaceKey: "session",
+	}); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	args, _ := os.ReadFile(argsFile)
+	want := "--app-name\ngomodoro\n--urgency\nnormal\n--print-id\n--\ngomodoro\nFinish break time\n"
+	if string(args) != want {
+		t.Errorf("notify-send arguments = %q, want %q", args, want)
+	}
+
+	actions := make(chan string, 1)
+
+	if err := n.Notify(ctx, notify.Notification{
+		Title:      "gomodoro",
+		Message:    "Finish work time",
+		Urgency:    notify.UrgencyCritical,
+		Icon:       "appointment-soon",
+		ReplaceKey: "session",
+		Actions:    []notify.Action{{Key: "start", Label: "Start break"}, {Key: "skip", Label: "Skip"}},
+		OnAction:   func(key string) { actions <- key },
+	}); err != nil {
+		t.Fatalf("Notify() returned error: %v", err)
+	}
+
+	if key := waitForAction(t, actions); key != "skip" {
+		t.Errorf("OnAction() called with %q, want skip", key)
+	}
+
+	args, _ = os.ReadFile(argsFile)
+	want = "--app-name\ngomodoro\n--urgency\ncritical\n--print-id\n--icon\nappointment-soon\n--replace-id\n7\n" +
+		"--action\nstart=Start break\n--action\nskip=Skip\n--wait\n--\ngomodoro\nFinish work time\n"
+	if string(args) != want {
+		t.Errorf("notify-send arguments = %q, want %q", args, want)
+	}
+}
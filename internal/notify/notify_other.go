@@ -1,14 +1,24 @@
-//go:build !darwin
-// +build !darwin
+//go:build !darwin && !linux
+// +build !darwin,!linux
 
-// Package notify notification
 package notify
 
 import (
+	"context"
 	"errors"
 )
 
-// Notify return unsupported error.
-func Notify(_, _ string) error {
-	return errors.New("unsupported notification")
+type unsupportedBackend struct{}
+
+func newBackend() backend {
+	return unsupportedBackend{}
+}
+
+// notify return unsupported error.
+func (unsupportedBackend) notify(context.Context, Notification, uint32) (uint32, error) {
+	return 0, errors.New("unsupported notification")
+}
+
+func (unsupportedBackend) close() error {
+	return nil
 }
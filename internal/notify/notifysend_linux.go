@@ -0,0 +1,129 @@
+//go:build linux
+// +build linux
+
+package notify
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// notifySend shows notifications with the notify-send command of libnotify.
+// Replacing and actions need libnotify 0.7.10 or later.
+type notifySend struct {
+	mu      sync.Mutex
+	waiting map[*exec.Cmd]struct{}
+}
+
+func newNotifySend() *notifySend {
+	return &notifySend{waiting: make(map[*exec.Cmd]struct{})}
+}
+
+func (s *notifySend) notify(ctx context.Context, n Notification, replacesID uint32) (uint32, error) {
+	path, err := exec.LookPath("notify-send")
+	if err != nil {
+		return 0, err
+	}
+
+	args := []string{"--app-name", AppName, "--urgency", n.Urgency.String(), "--print-id"}
+
+	if n.Icon != "" {
+		args = append(args, "--icon", n.Icon)
+	}
+
+	if replacesID != 0 {
+		args = append(args, "--replace-id", strconv.FormatUint(uint64(replacesID), 10))
+	}
+
+	if n.OnAction == nil || len(n.Actions) == 0 {
+		args = append(args, "--", n.Title, n.Message)
+
+		out, err := exec.CommandContext(ctx, path, args...).Output()
+		if err != nil {
+			return 0, fmt.Errorf("failed to run notify-send: %w", err)
+		}
+
+		return parseID(strings.SplitN(string(out), "\n", 2)[0]), nil //nolint:mnd
+	}
+
+	for _, a := range n.Actions {
+		args = append(args, "--action", a.Key+"="+a.Label)
+	}
+
+	args = append(args, "--wait", "--", n.Title, n.Message)
+
+	return s.notifyAndWait(path, args, n.OnAction)
+}
+
+// notifyAndWait runs notify-send until the notification is closed, which prints the ID and then the clicked action.
+// The command is not bound to a context because it outlives the call, and is killed by close instead.
+func (s *notifySend) notifyAndWait(path string, args []string, onAction func(string)) (uint32, error) {
+	cmd := exec.Command(path, args...) //nolint:gosec
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create notify-send pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("failed to run notify-send: %w", err)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	if !scanner.Scan() {
+		err := errors.Join(scanner.Err(), cmd.Wait())
+		if err == nil {
+			err = errors.New("no notification ID")
+		}
+
+		return 0, fmt.Errorf("failed to run notify-send: %w", err)
+	}
+
+	id := parseID(scanner.Text())
+
+	s.mu.Lock()
+	s.waiting[cmd] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		if scanner.Scan() {
+			if key := strings.TrimSpace(scanner.Text()); key != "" {
+				onAction(key)
+			}
+		}
+
+		_ = cmd.Wait()
+
+		s.mu.Lock()
+		delete(s.waiting, cmd)
+		s.mu.Unlock()
+	}()
+
+	return id, nil
+}
+
+// close kills the notify-send commands that are waiting for an action.
+func (s *notifySend) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for cmd := range s.waiting {
+		_ = cmd.Process.Kill()
+	}
+}
+
+// parseID returns the notification ID printed by notify-send, or 0 if it printed none.
+func parseID(s string) uint32 {
+	id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return 0
+	}
+
+	return uint32(id)
+}
@@ -0,0 +1,401 @@
+// Package report renders focus reports built from the stats and history of pomodoro sessions
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/core"
+)
+
+// Format is an output format of a report.
+type Format string
+
+const (
+	// FormatTable renders aligned plain text for the terminal.
+	FormatTable Format = "table"
+	// FormatJSON renders a single JSON document.
+	FormatJSON Format = "json"
+	// FormatCSV renders the groups, or the sessions when they are included, as CSV for spreadsheets.
+	FormatCSV Format = "csv"
+	// FormatMarkdown renders markdown tables for pasting into standup notes.
+	FormatMarkdown Format = "markdown"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatTable, FormatJSON, FormatCSV, FormatMarkdown}
+
+// ParseFormat returns the format with the given name.
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown report format: %s", name)
+}
+
+// Report is the focus of a period.
+type Report struct {
+	// Since and Until bound the period, [Since, Until).
+	Since   time.Time
+	Until   time.Time
+	GroupBy core.StatsGroupBy
+	Stats   *core.Stats
+	// Sessions are the work sessions of the period. Nil leaves them out of the report.
+	Sessions []*core.Pomodoro
+	// TaskTitles maps task IDs to titles. Tasks missing from it are shown by ID.
+	TaskTitles map[string]string
+}
+
+// row is a line of the groups table.
+type row struct {
+	key   string
+	label string
+	core.FocusStats
+}
+
+// Write renders the report in the format.
+func (r *Report) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatTable:
+		return r.writeTable(w)
+	case FormatJSON:
+		return r.writeJSON(w)
+	case FormatCSV:
+		return r.writeCSV(w)
+	case FormatMarkdown:
+		return r.writeMarkdown(w)
+	default:
+		return fmt.Errorf("unknown report format: %s", format)
+	}
+}
+
+func (r *Report) rows() []row {
+	rows := make([]row, len(r.Stats.Groups))
+	for i, group := range r.Stats.Groups {
+		rows[i] = row{key: group.Key, label: r.groupLabel(group.Key), FocusStats: group.FocusStats}
+	}
+
+	return rows
+}
+
+func (r *Report) groupLabel(key string) string {
+	switch r.GroupBy {
+	case core.StatsGroupByTask:
+		return r.taskLabel(key)
+	case core.StatsGroupByProject:
+		if key == "" {
+			return "(no project)"
+		}
+	case core.StatsGroupByTag:
+		if key == "" {
+			return "(untagged)"
+		}
+	case core.StatsGroupByDay, core.StatsGroupByWeek:
+	}
+
+	return key
+}
+
+func (r *Report) taskLabel(id string) string {
+	if id == "" {
+		return "(no task)"
+	}
+
+	if title, ok := r.TaskTitles[id]; ok {
+		return title
+	}
+
+	return id
+}
+
+// groupHeader names the group column.
+func (r *Report) groupHeader() string {
+	switch r.GroupBy {
+	case core.StatsGroupByDay:
+		return "Day"
+	case core.StatsGroupByWeek:
+		return "Week"
+	case core.StatsGroupByTask:
+		return "Task"
+	case core.StatsGroupByProject:
+		return "Project"
+	case core.StatsGroupByTag:
+		return "Tag"
+	default:
+		return "Group"
+	}
+}
+
+// period describes the range of the report, showing whole days as dates.
+func (r *Report) period() string {
+	if isMidnight(r.Since) && isMidnight(r.Until) {
+		return r.Since.Format(time.DateOnly) + " to " + r.Until.AddDate(0, 0, -1).Format(time.DateOnly)
+	}
+
+	const layout = "2006-01-02 15:04"
+
+	return r.Since.Format(layout) + " to " + r.Until.Format(layout)
+}
+
+func (r *Report) writeTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintf(tw, "Focus report: %s\n\n", r.period())
+	fmt.Fprintf(tw, "%s\tFOCUS\tSESSIONS\tINTERRUPTIONS\tBREAKS TAKEN\n", strings.ToUpper(r.groupHeader()))
+
+	for _, row := range r.rows() {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n",
+			row.label, formatDuration(row.FocusTime), row.Sessions, row.Interruptions, formatAdherence(row.BreakAdherence))
+	}
+
+	total := r.Stats.Total
+	fmt.Fprintf(tw, "TOTAL\t%s\t%d\t%d\t%s\n",
+		formatDuration(total.FocusTime), total.Sessions, total.Interruptions, formatAdherence(total.BreakAdherence))
+
+	if r.Sessions != nil {
+		fmt.Fprintf(tw, "\nSTART\tEND\tTASK\tFOCUS\tSTATUS\n")
+
+		for _, p := range r.Sessions {
+			start, end := r.sessionTimes(p)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+				start, end, r.taskLabel(p.TaskID), formatDuration(p.ElapsedTime), sessionStatus(p))
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Report) writeMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## Focus report: %s\n\n", r.period())
+	fmt.Fprintf(&b, "| %s | Focus | Sessions | Interruptions | Breaks taken |\n", r.groupHeader())
+	b.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+
+	for _, row := range r.rows() {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %s |\n",
+			escapeMarkdown(row.label), formatDuration(row.FocusTime), row.Sessions, row.Interruptions,
+			formatAdherence(row.BreakAdherence))
+	}
+
+	total := r.Stats.Total
+	fmt.Fprintf(&b, "| **Total** | **%s** | **%d** | **%d** | **%s** |\n",
+		formatDuration(total.FocusTime), total.Sessions, total.Interruptions, formatAdherence(total.BreakAdherence))
+
+	if r.Sessions != nil {
+		b.WriteString("\n### Sessions\n\n")
+		b.WriteString("| Start | End | Task | Focus | Status |\n")
+		b.WriteString("| --- | --- | --- | ---: | --- |\n")
+
+		for _, p := range r.Sessions {
+			start, end := r.sessionTimes(p)
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+				start, end, escapeMarkdown(r.taskLabel(p.TaskID)), formatDuration(p.ElapsedTime), sessionStatus(p))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Report) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	var records [][]string
+
+	if r.Sessions != nil {
+		records = append(records, []string{"id", "task_id", "task", "start_time", "end_time", "focus_time_sec", "status"})
+
+		for _, p := range r.Sessions {
+			records = append(records, []string{
+				p.ID,
+				p.TaskID,
+				r.taskLabel(p.TaskID),
+				p.StartTime.Format(time.RFC3339),
+				formatOptionalTime(p.EndTime),
+				strconv.Itoa(int(p.ElapsedTime.Seconds())),
+				sessionStatus(p),
+			})
+		}
+	} else {
+		records = append(records, []string{
+			string(r.GroupBy), "label", "focus_time_sec", "focus_hours", "sessions", "interruptions", "break_adherence",
+		})
+
+		for _, row := range r.rows() {
+			adherence := ""
+			if row.BreakAdherence != nil {
+				adherence = strconv.FormatFloat(*row.BreakAdherence, 'f', 2, 64)
+			}
+
+			records = append(records, []string{
+				row.key,
+				row.label,
+				strconv.Itoa(int(row.FocusTime.Seconds())),
+				strconv.FormatFloat(row.FocusTime.Hours(), 'f', 2, 64),
+				strconv.Itoa(row.Sessions),
+				strconv.Itoa(row.Interruptions),
+				adherence,
+			})
+		}
+	}
+
+	if err := cw.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+type jsonStats struct {
+	FocusTimeSec   int      `json:"focus_time_sec"`
+	Sessions       int      `json:"sessions"`
+	Interruptions  int      `json:"interruptions"`
+	BreakAdherence *float64 `json:"break_adherence"`
+}
+
+type jsonGroup struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	jsonStats
+}
+
+type jsonSession struct {
+	ID           string     `json:"id"`
+	TaskID       string     `json:"task_id,omitempty"`
+	Task         string     `json:"task"`
+	StartTime    time.Time  `json:"start_time"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+	FocusTimeSec int        `json:"focus_time_sec"`
+	Status       string     `json:"status"`
+}
+
+type jsonReport struct {
+	Since    time.Time         `json:"since"`
+	Until    time.Time         `json:"until"`
+	GroupBy  core.StatsGroupBy `json:"group_by"`
+	Total    jsonStats         `json:"total"`
+	Groups   []jsonGroup       `json:"groups"`
+	Sessions []jsonSession     `json:"sessions,omitempty"`
+}
+
+func toJSONStats(s core.FocusStats) jsonStats {
+	return jsonStats{
+		FocusTimeSec:   int(s.FocusTime.Seconds()),
+		Sessions:       s.Sessions,
+		Interruptions:  s.Interruptions,
+		BreakAdherence: s.BreakAdherence,
+	}
+}
+
+func (r *Report) writeJSON(w io.Writer) error {
+	out := jsonReport{
+		Since:   r.Since,
+		Until:   r.Until,
+		GroupBy: r.GroupBy,
+		Total:   toJSONStats(r.Stats.Total),
+		Groups:  []jsonGroup{},
+	}
+
+	for _, row := range r.rows() {
+		out.Groups = append(out.Groups, jsonGroup{Key: row.key, Label: row.label, jsonStats: toJSONStats(row.FocusStats)})
+	}
+
+	for _, p := range r.Sessions {
+		session := jsonSession{
+			ID:           p.ID,
+			TaskID:       p.TaskID,
+			Task:         r.taskLabel(p.TaskID),
+			StartTime:    p.StartTime,
+			FocusTimeSec: int(p.ElapsedTime.Seconds()),
+			Status:       sessionStatus(p),
+		}
+
+		if !p.EndTime.IsZero() {
+			session.EndTime = &p.EndTime
+		}
+
+		out.Sessions = append(out.Sessions, session)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+// sessionTimes formats the start and end of a session in the time zone of the report.
+// The end omits the date when the session ended on the day it started.
+func (r *Report) sessionTimes(p *core.Pomodoro) (string, string) {
+	const layout = "2006-01-02 15:04"
+
+	start := p.StartTime.In(r.Since.Location())
+	if p.EndTime.IsZero() {
+		return start.Format(layout), "-"
+	}
+
+	end := p.EndTime.In(r.Since.Location())
+	if end.Format(time.DateOnly) == start.Format(time.DateOnly) {
+		return start.Format(layout), end.Format("15:04")
+	}
+
+	return start.Format(layout), end.Format(layout)
+}
+
+func sessionStatus(p *core.Pomodoro) string {
+	if p.Interrupted() {
+		return "interrupted"
+	}
+
+	return "completed"
+}
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60) //nolint:mnd
+}
+
+func formatAdherence(adherence *float64) string {
+	if adherence == nil {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.0f%%", *adherence*100) //nolint:mnd
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func escapeMarkdown(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Equal(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()))
+}
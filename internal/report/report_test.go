@@ -0,0 +1,162 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/report"
+)
+
+var since = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newReport(groupBy core.StatsGroupBy, keys ...string) *report.Report {
+	half := 0.5
+
+	stats := &core.Stats{
+		Start: since,
+		End:   since.AddDate(0, 0, 7),
+		Total: core.FocusStats{FocusTime: 85 * time.Minute, Sessions: 3, Interruptions: 1, BreakAdherence: &half},
+	}
+
+	for i, key := range keys {
+		stats.Groups = append(stats.Groups, &core.StatsGroup{
+			Key:        key,
+			FocusStats: core.FocusStats{FocusTime: time.Duration(60-i*35) * time.Minute, Sessions: 2 - i},
+		})
+	}
+
+	return &report.Report{
+		Since:      since,
+		Until:      since.AddDate(0, 0, 7),
+		GroupBy:    groupBy,
+		Stats:      stats,
+		TaskTitles: map[string]string{"a": "write | docs"},
+	}
+}
+
+func write(t *testing.T, r *report.Report, format report.Format) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := r.Write(&buf, format); err != nil {
+		t.Fatalf("Write(%s) error = %v", format, err)
+	}
+
+	return buf.String()
+}
+
+func TestReportTable(t *testing.T) {
+	t.Parallel()
+
+	got := write(t, newReport(core.StatsGroupByDay, "2025-01-01", "2025-01-02"), report.FormatTable)
+	want := `Focus report: 2025-01-01 to 2025-01-07
+
+DAY         FOCUS  SESSIONS  INTERRUPTIONS  BREAKS TAKEN
+2025-01-01  1h00m  2         0              -
+2025-01-02  0h25m  1         0              -
+TOTAL       1h25m  3         1              50%
+`
+
+	if got != want {
+		t.Errorf("table =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestReportMarkdown(t *testing.T) {
+	t.Parallel()
+
+	r := newReport(core.StatsGroupByTask, "a", "")
+	r.Sessions = []*core.Pomodoro{
+		{
+			ID:            "1",
+			TaskID:        "a",
+			StartTime:     since.Add(9 * time.Hour),
+			EndTime:       since.Add(9*time.Hour + 10*time.Minute),
+			ElapsedTime:   10 * time.Minute,
+			PhaseDuration: 25 * time.Minute,
+		},
+	}
+
+	got := write(t, r, report.FormatMarkdown)
+	want := `## Focus report: 2025-01-01 to 2025-01-07
+
+| Task | Focus | Sessions | Interruptions | Breaks taken |
+| --- | ---: | ---: | ---: | ---: |
+| write \| docs | 1h00m | 2 | 0 | - |
+| (no task) | 0h25m | 1 | 0 | - |
+| **Total** | **1h25m** | **3** | **1** | **50%** |
+
+### Sessions
+
+| Start | End | Task | Focus | Status |
+| --- | --- | --- | ---: | --- |
+| 2025-01-01 09:00 | 09:10 | write \| docs | 0h10m | interrupted |
+`
+
+	if got != want {
+		t.Errorf("markdown =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestReportCSV(t *testing.T) {
+	t.Parallel()
+
+	got := write(t, newReport(core.StatsGroupByTag, "docs", ""), report.FormatCSV)
+	want := `tag,label,focus_time_sec,focus_hours,sessions,interruptions,break_adherence
+docs,docs,3600,1.00,2,0,
+,(untagged),1500,0.42,1,0,
+`
+
+	if got != want {
+		t.Errorf("csv =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestReportJSON(t *testing.T) {
+	t.Parallel()
+
+	got := write(t, newReport(core.StatsGroupByProject, "gomodoro"), report.FormatJSON)
+
+	var decoded struct {
+		GroupBy string `json:"group_by"`
+		Total   struct {
+			FocusTimeSec   int      `json:"focus_time_sec"`
+			BreakAdherence *float64 `json:"break_adherence"`
+		} `json:"total"`
+		Groups []struct {
+			Key            string   `json:"key"`
+			BreakAdherence *float64 `json:"break_adherence"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if decoded.GroupBy != "project" || decoded.Total.FocusTimeSec != 5100 || *decoded.Total.BreakAdherence != 0.5 {
+		t.Errorf("json = %s", got)
+	}
+
+	if len(decoded.Groups) != 1 || decoded.Groups[0].Key != "gomodoro" || decoded.Groups[0].BreakAdherence != nil {
+		t.Errorf("json groups = %s", got)
+	}
+
+	if strings.Contains(got, `"sessions": [`) {
+		t.Errorf("json includes sessions that were not asked for: %s", got)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	if f, err := report.ParseFormat("markdown"); err != nil || f != report.FormatMarkdown {
+		t.Errorf("ParseFormat(markdown) = %s, %v", f, err)
+	}
+
+	if _, err := report.ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) succeeded, want error")
+	}
+}
@@ -0,0 +1,108 @@
+// Package sound plays alarms and ticks
+package sound
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/jfreymuth/oggvorbis"
+)
+
+// Clip is decoded audio.
+type Clip struct {
+	SampleRate int
+	Channels   int
+	// Samples are interleaved by channel and range from -1 to 1.
+	Samples []float32
+}
+
+// ErrUnsupportedFormat is returned for audio that is neither WAV nor Ogg Vorbis.
+var ErrUnsupportedFormat = errors.New("unsupported audio format, want WAV or Ogg Vorbis")
+
+// Decode decodes WAV or Ogg Vorbis audio, detected from its header.
+func Decode(r io.Reader) (*Clip, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audio: %w", err)
+	}
+
+	switch {
+	case len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WAVE":
+		return decodeWAV(data)
+	case len(data) >= 4 && string(data[0:4]) == "OggS":
+		samples, format, err := oggvorbis.ReadAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode Ogg Vorbis: %w", err)
+		}
+
+		return &Clip{SampleRate: format.SampleRate, Channels: format.Channels, Samples: samples}, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// DecodeFile decodes a WAV or Ogg Vorbis file.
+func DecodeFile(path string) (*Clip, error) {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sound file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	clip, err := Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return clip, nil
+}
+
+// tone describes a note of a synthesized clip.
+type tone struct {
+	frequency float64
+	seconds   float64
+}
+
+const (
+	synthSampleRate = 44100
+	// synthDecay is how fast a synthesized note fades, in 1/seconds.
+	synthDecay = 6
+	// synthAmplitude leaves headroom so that a synthesized clip at full volume does not clip.
+	synthAmplitude = 0.6
+)
+
+// synthesize renders notes as decaying sine waves, so that the default sounds need no files.
+func synthesize(decay float64, tones ...tone) *Clip {
+	clip := &Clip{SampleRate: synthSampleRate, Channels: 1}
+
+	for _, t := range tones {
+		n := int(t.seconds * synthSampleRate)
+		for i := range n {
+			s := float64(i) / synthSampleRate
+			v := synthAmplitude * math.Exp(-decay*s) * math.Sin(2*math.Pi*t.frequency*s)
+			clip.Samples = append(clip.Samples, float32(v))
+		}
+	}
+
+	return clip
+}
+
+// defaultClip returns the built-in clip of a sound.
+//
+//nolint:mnd
+func defaultClip(s Sound) *Clip {
+	switch s {
+	case BreakEnd:
+		return synthesize(synthDecay, tone{660, 0.18}, tone{880, 0.18}, tone{1100, 0.5})
+	case Tick:
+		return synthesize(300, tone{1500, 0.02})
+	default:
+		return synthesize(synthDecay, tone{1320, 0.25}, tone{880, 0.6})
+	}
+}
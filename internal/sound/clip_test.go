@@ -0,0 +1,142 @@
+package sound_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/sound"
+)
+
+// wavFile builds a WAV file with the given format chunk fields and sample data.
+func wavFile(format, channels uint16, sampleRate uint32, bits uint16, data []byte) []byte {
+	var b []byte
+
+	b = append(b, "RIFF"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(4+8+16+8+len(data)+8+2))
+	b = append(b, "WAVE"...)
+
+	b = append(b, "fmt "...)
+	b = binary.LittleEndian.AppendUint32(b, 16)
+	b = binary.LittleEndian.AppendUint16(b, format)
+	b = binary.LittleEndian.AppendUint16(b, channels)
+	b = binary.LittleEndian.AppendUint32(b, sampleRate)
+	b = binary.LittleEndian.AppendUint32(b, sampleRate*uint32(channels*bits/8))
+	b = binary.LittleEndian.AppendUint16(b, channels*bits/8)
+	b = binary.LittleEndian.AppendUint16(b, bits)
+
+	// An odd-sized chunk before the data is padded and skipped.
+	b = append(b, "LIST"...)
+	b = binary.LittleEndian.AppendUint32(b, 1)
+	b = append(b, 0, 0)
+
+	b = append(b, "data"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data)))
+	b = append(b, data...)
+
+	return b
+}
+
+func TestDecodeWAV(t *testing.T) {
+	tests := map[string]struct {
+		format uint16
+		bits   uint16
+		data   []byte
+	}{
+		"8-bit": {
+			format: 1,
+			bits:   8,
+			data:   []byte{0, 128, 192},
+		},
+		"16-bit": {
+			format: 1,
+			bits:   16,
+			data:   []byte{0x00, 0x80, 0x00, 0x00, 0x00, 0x40},
+		},
+		"24-bit": {
+			format: 1,
+			bits:   24,
+			data:   []byte{0x00, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40},
+		},
+		"32-bit": {
+			format: 1,
+			bits:   32,
+			data:   []byte{0, 0, 0, 0x80, 0, 0, 0, 0, 0, 0, 0, 0x40},
+		},
+		"float": {
+			format: 3,
+			bits:   32,
+			data: binary.LittleEndian.AppendUint32(
+				binary.LittleEndian.AppendUint32(
+					binary.LittleEndian.AppendUint32(nil, math.Float32bits(-1)),
+					math.Float32bits(0),
+				),
+				math.Float32bits(0.5),
+			),
+		},
+	}
+
+	want := []float32{-1, 0, 0.5}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			clip, err := sound.Decode(bytes.NewReader(wavFile(tt.format, 1, 8000, tt.bits, tt.data)))
+			if err != nil {
+				t.Fatalf("Decode() returned error: %v", err)
+			}
+
+			if clip.SampleRate != 8000 || clip.Channels != 1 {
+				t.Errorf("format = %d Hz, %d channels, want 8000 Hz, 1 channel", clip.SampleRate, clip.Channels)
+			}
+
+			if len(clip.Samples) != len(want) {
+				t.Fatalf("Decode() returned %d samples, want %d", len(clip.Samples), len(want))
+			}
+
+			for i, s := range clip.Samples {
+				if math.Abs(float64(s-want[i])) > 1e-6 {
+					t.Errorf("sample %d = %v, want %v", i, s, want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Ogg Vorbis", func(t *testing.T) {
+		// testdata/test.ogg is from github.com/jfreymuth/oggvorbis.
+		clip, err := sound.DecodeFile("testdata/test.ogg")
+		if err != nil {
+			t.Fatalf("DecodeFile() returned error: %v", err)
+		}
+
+		if clip.SampleRate != 44100 || clip.Channels != 1 || len(clip.Samples) != 44100 {
+			t.Errorf("clip = %d Hz, %d channels, %d samples, want 44100 Hz, 1 channel, 44100 samples",
+				clip.SampleRate, clip.Channels, len(clip.Samples))
+		}
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := sound.Decode(bytes.NewReader([]byte("ID3 not a wav")))
+		if !errors.Is(err, sound.ErrUnsupportedFormat) {
+			t.Errorf("Decode() returned %v, want ErrUnsupportedFormat", err)
+		}
+	})
+
+	t.Run("unsupported WAV encoding", func(t *testing.T) {
+		_, err := sound.Decode(bytes.NewReader(wavFile(1, 1, 8000, 12, []byte{0, 0})))
+		if err == nil {
+			t.Error("Decode() succeeded, want error")
+		}
+	})
+
+	t.Run("WAV without data", func(t *testing.T) {
+		data := wavFile(1, 1, 8000, 16, nil)
+		_, err := sound.Decode(bytes.NewReader(data[:len(data)-8]))
+		if err == nil {
+			t.Error("Decode() succeeded, want error")
+		}
+	})
+}
@@ -0,0 +1,20 @@
+//go:build darwin
+// +build darwin
+
+package sound
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// playCommand plays a WAV file with afplay.
+func playCommand(ctx context.Context, file string) (*exec.Cmd, error) {
+	path, err := exec.LookPath("afplay")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNoPlayer, err)
+	}
+
+	return exec.CommandContext(ctx, path, file), nil
+}
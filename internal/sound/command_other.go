@@ -0,0 +1,33 @@
+//go:build !darwin && !windows
+// +build !darwin,!windows
+
+package sound
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// players are the audio players tried in order: PulseAudio, PipeWire and ALSA.
+var players = [][]string{
+	{"paplay"},
+	{"pw-play"},
+	{"aplay", "-q"},
+}
+
+// playCommand plays a WAV file with the first installed player.
+func playCommand(ctx context.Context, file string) (*exec.Cmd, error) {
+	for _, player := range players {
+		path, err := exec.LookPath(player[0])
+		if err != nil {
+			continue
+		}
+
+		args := append(player[1:len(player):len(player)], file)
+
+		return exec.CommandContext(ctx, path, args...), nil
+	}
+
+	return nil, fmt.Errorf("%w, install paplay, pw-play or aplay", ErrNoPlayer)
+}
@@ -0,0 +1,23 @@
+//go:build windows
+// +build windows
+
+package sound
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// playCommand plays a WAV file with the SoundPlayer of .NET through PowerShell.
+func playCommand(ctx context.Context, file string) (*exec.Cmd, error) {
+	path, err := exec.LookPath("powershell")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNoPlayer, err)
+	}
+
+	script := "(New-Object Media.SoundPlayer '" + strings.ReplaceAll(file, "'", "''") + "').PlaySync()"
+
+	return exec.CommandContext(ctx, path, "-NoProfile", "-NonInteractive", "-Command", script), nil
+}
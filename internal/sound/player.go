@@ -0,0 +1,141 @@
+package sound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/hatappi/gomodoro/internal/config"
+)
+
+// Sound is a sound of the Player.
+type Sound string
+
+const (
+	// PhaseEnd is played when a work phase ends.
+	PhaseEnd Sound = "phase_end"
+	// BreakEnd is played when a break ends.
+	BreakEnd Sound = "break_end"
+	// Tick is played every second of a work phase.
+	Tick Sound = "tick"
+)
+
+// ErrNoPlayer is returned by Player.Play when no audio player of the OS is installed.
+var ErrNoPlayer = errors.New("no audio player found")
+
+// Player plays the configured sounds with the audio player of the OS, e.g. paplay or afplay.
+// The sounds are decoded in Go and handed to the player as 16-bit WAV files, so no cgo is needed.
+type Player struct {
+	dir   string
+	files map[Sound]string
+	tick  atomic.Bool
+	muted atomic.Bool
+}
+
+// New decodes the sounds of the configuration and creates a new Player.
+func New(cfg config.SoundConfig) (*Player, error) {
+	volume := float64(cfg.Volume) / 100 //nolint:mnd
+
+	breakEnd := cfg.BreakEnd
+	if breakEnd == "" {
+		breakEnd = cfg.PhaseEnd
+	}
+
+	paths := map[Sound]string{
+		PhaseEnd: cfg.PhaseEnd,
+		BreakEnd: breakEnd,
+		Tick:     cfg.Tick,
+	}
+
+	dir, err := os.MkdirTemp("", "gomodoro-sound-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sound directory: %w", err)
+	}
+
+	p := &Player{
+		dir:   dir,
+		files: make(map[Sound]string, len(paths)),
+	}
+	p.tick.Store(cfg.TickEnable)
+	p.muted.Store(cfg.Mute)
+
+	for s, path := range paths {
+		clip := defaultClip(s)
+		if path != "" {
+			if clip, err = DecodeFile(path); err != nil {
+				_ = p.Close()
+				return nil, err
+			}
+		}
+
+		file := filepath.Join(dir, string(s)+".wav")
+		if err := os.WriteFile(file, encodeWAV(clip, volume), 0o600); err != nil {
+			_ = p.Close()
+			return nil, fmt.Errorf("failed to write sound: %w", err)
+		}
+
+		p.files[s] = file
+	}
+
+	return p, nil
+}
+
+// Play plays a sound and returns when it has finished. A muted Player plays nothing.
+func (p *Player) Play(ctx context.Context, s Sound) error {
+	if p.Muted() {
+		return nil
+	}
+
+	file, ok := p.files[s]
+	if !ok {
+		return fmt.Errorf("unknown sound %q", s)
+	}
+
+	cmd, err := playCommand(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to play sound with %s: %w: %s", filepath.Base(cmd.Path), err, out)
+	}
+
+	return nil
+}
+
+// TickEnabled reports whether Tick is played during work phases.
+func (p *Player) TickEnabled() bool {
+	return p.tick.Load()
+}
+
+// DisableTick stops Tick from being played, e.g. when no audio player is installed.
+func (p *Player) DisableTick() {
+	p.tick.Store(false)
+}
+
+// Muted reports whether the Player is muted.
+func (p *Player) Muted() bool {
+	return p.muted.Load()
+}
+
+// ToggleMute mutes the Player, or unmutes it if it is muted, and returns whether it is muted now.
+func (p *Player) ToggleMute() bool {
+	for {
+		muted := p.muted.Load()
+		if p.muted.CompareAndSwap(muted, !muted) {
+			return !muted
+		}
+	}
+}
+
+// Close removes the decoded sounds.
+func (p *Player) Close() error {
+	if err := os.RemoveAll(p.dir); err != nil {
+		return fmt.Errorf("failed to remove sound directory: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+//go:build !darwin && !windows
+// +build !darwin,!windows
+
+package sound_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/sound"
+)
+
+// fakePlayer installs a paplay that copies the played file into dir as played-<n>.wav.
+func fakePlayer(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	script := `#!/bin/sh
+n=$(ls ` + dir + ` | grep -c '^played-')
+cp "$1" ` + dir + `/played-$n.wav
+`
+	if err := os.WriteFile(filepath.Join(dir, "paplay"), []byte(script), 0o700); err != nil { //nolint:gosec
+		t.Fatalf("failed to write fake paplay: %v", err)
+	}
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	return dir
+}
+
+func played(t *testing.T, dir string) []*sound.Clip {
+	t.Helper()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "played-*.wav"))
+
+	clips := make([]*sound.Clip, 0, len(files))
+	for _, file := range files {
+		clip, err := sound.DecodeFile(file)
+		if err != nil {
+			t.Fatalf("played file is not a WAV: %v", err)
+		}
+
+		clips = append(clips, clip)
+	}
+
+	return clips
+}
+
+func TestPlayer(t *testing.T) {
+	ctx := context.Background()
+
+	// A quarter-scale 16-bit square wave, played at half volume.
+	src := filepath.Join(t.TempDir(), "end.wav")
+	if err := os.WriteFile(src, wavFile(1, 2, 22050, 16, []byte{0x00, 0x20, 0x00, 0xe0}), 0o600); err != nil {
+		t.Fatalf("failed to write sound: %v", err)
+	}
+
+	dir := fakePlayer(t)
+
+	p, err := sound.New(config.SoundConfig{Volume: 50, PhaseEnd: src})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	t.Cleanup(func() { _ = p.Close() })
+
+	if err := p.Play(ctx, sound.PhaseEnd); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+
+	clips := played(t, dir)
+	if len(clips) != 1 {
+		t.Fatalf("played %d sounds, want 1", len(clips))
+	}
+
+	got := clips[0]
+	if got.SampleRate != 22050 || got.Channels != 2 || len(got.Samples) != 2 {
+		t.Fatalf("played %d Hz, %d channels, %d samples, want the file", got.SampleRate, got.Channels, len(got.Samples))
+	}
+
+	if math.Abs(float64(got.Samples[0])-0.125) > 1e-3 || math.Abs(float64(got.Samples[1])+0.125) > 1e-3 {
+		t.Errorf("played samples %v, want ±0.125", got.Samples)
+	}
+
+	// Without break_end, the end of a break plays phase_end as well.
+	if err := p.Play(ctx, sound.BreakEnd); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+
+	if clips := played(t, dir); len(clips) != 2 || len(clips[1].Samples) != 2 {
+		t.Errorf("break end did not play phase_end")
+	}
+
+	if !p.ToggleMute() || !p.Muted() {
+		t.Fatal("ToggleMute() did not mute")
+	}
+
+	if err := p.Play(ctx, sound.Tick); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+
+	if clips := played(t, dir); len(clips) != 2 {
+		t.Errorf("muted player played %d sounds, want 2", len(clips))
+	}
+
+	if p.ToggleMute() {
+		t.Fatal("ToggleMute() did not unmute")
+	}
+
+	// The built-in tick is played without a file.
+	if err := p.Play(ctx, sound.Tick); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+
+	if clips := played(t, dir); len(clips) != 3 || clips[2].SampleRate != 44100 {
+		t.Errorf("built-in tick was not played")
+	}
+}
+
+func TestPlayerErrors(t *testing.T) {
+	t.Run("invalid file", func(t *testing.T) {
+		src := filepath.Join(t.TempDir(), "tick.mp3")
+		if err := os.WriteFile(src, []byte("ID3"), 0o600); err != nil {
+			t.Fatalf("failed to write sound: %v", err)
+		}
+
+		_, err := sound.New(config.SoundConfig{Volume: 100, Tick: src})
+		if err == nil || !strings.Contains(err.Error(), "tick.mp3") {
+			t.Errorf("New() returned %v, want error about tick.mp3", err)
+		}
+	})
+
+	t.Run("no player", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+
+		p, err := sound.New(config.SoundConfig{Volume: 100})
+		if err != nil {
+			t.Fatalf("New() returned error: %v", err)
+		}
+		t.Cleanup(func() { _ = p.Close() })
+
+		if err := p.Play(context.Background(), sound.PhaseEnd); !errors.Is(err, sound.ErrNoPlayer) {
+			t.Errorf("Play() returned %v, want ErrNoPlayer", err)
+		}
+	})
+}
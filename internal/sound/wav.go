@@ -0,0 +1,130 @@
+package sound
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	wavFormatPCM        = 1
+	wavFormatFloat      = 3
+	wavFormatExtensible = 0xFFFE
+
+	// wavHeaderSize is the size of the header written by encodeWAV.
+	wavHeaderSize = 44
+)
+
+// decodeWAV decodes a RIFF WAVE file with 8, 16, 24 or 32-bit integer or 32-bit float samples.
+//
+//nolint:mnd
+func decodeWAV(data []byte) (*Clip, error) {
+	var (
+		format, channels, bits uint16
+		sampleRate             uint32
+		samples                []byte
+		hasFormat              bool
+	)
+
+	for rest := data[12:]; len(rest) >= 8; {
+		id := string(rest[0:4])
+		size := int(binary.LittleEndian.Uint32(rest[4:8]))
+		rest = rest[8:]
+
+		if size > len(rest) {
+			// Some writers leave the size of a streamed data chunk unset.
+			size = len(rest)
+		}
+
+		chunk := rest[:size]
+
+		switch id {
+		case "fmt ":
+			if len(chunk) < 16 {
+				return nil, errors.New("invalid WAV format chunk")
+			}
+
+			format = binary.LittleEndian.Uint16(chunk[0:2])
+			channels = binary.LittleEndian.Uint16(chunk[2:4])
+			sampleRate = binary.LittleEndian.Uint32(chunk[4:8])
+			bits = binary.LittleEndian.Uint16(chunk[14:16])
+			hasFormat = true
+
+			if format == wavFormatExtensible && len(chunk) >= 26 {
+				format = binary.LittleEndian.Uint16(chunk[24:26])
+			}
+		case "data":
+			samples = chunk
+		}
+
+		// Chunks are padded to an even size.
+		rest = rest[min(size+size%2, len(rest)):]
+	}
+
+	if !hasFormat || samples == nil {
+		return nil, errors.New("WAV has no format or data chunk")
+	}
+
+	if channels == 0 || sampleRate == 0 {
+		return nil, errors.New("WAV has no channels or sample rate")
+	}
+
+	clip := &Clip{SampleRate: int(sampleRate), Channels: int(channels)}
+
+	var decode func(b []byte) float32
+
+	switch {
+	case format == wavFormatPCM && bits == 8:
+		decode = func(b []byte) float32 { return float32(int(b[0])-128) / 128 }
+	case format == wavFormatPCM && bits == 16:
+		decode = func(b []byte) float32 { return float32(int16(binary.LittleEndian.Uint16(b))) / (1 << 15) }
+	case format == wavFormatPCM && bits == 24:
+		decode = func(b []byte) float32 {
+			return float32(int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24)>>8) / (1 << 23)
+		}
+	case format == wavFormatPCM && bits == 32:
+		decode = func(b []byte) float32 { return float32(int32(binary.LittleEndian.Uint32(b))) / (1 << 31) }
+	case format == wavFormatFloat && bits == 32:
+		decode = func(b []byte) float32 { return math.Float32frombits(binary.LittleEndian.Uint32(b)) }
+	default:
+		return nil, fmt.Errorf("unsupported WAV encoding: format %d with %d bits", format, bits)
+	}
+
+	width := int(bits) / 8
+	clip.Samples = make([]float32, 0, len(samples)/width)
+
+	for i := 0; i+width <= len(samples); i += width {
+		clip.Samples = append(clip.Samples, decode(samples[i:i+width]))
+	}
+
+	return clip, nil
+}
+
+// encodeWAV encodes the clip as a 16-bit PCM WAV file with the samples scaled by volume, from 0 to 1.
+//
+//nolint:mnd,gosec
+func encodeWAV(clip *Clip, volume float64) []byte {
+	dataSize := len(clip.Samples) * 2
+
+	b := make([]byte, 0, wavHeaderSize+dataSize)
+	b = append(b, "RIFF"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(wavHeaderSize-8+dataSize))
+	b = append(b, "WAVEfmt "...)
+	b = binary.LittleEndian.AppendUint32(b, 16)
+	b = binary.LittleEndian.AppendUint16(b, wavFormatPCM)
+	b = binary.LittleEndian.AppendUint16(b, uint16(clip.Channels))
+	b = binary.LittleEndian.AppendUint32(b, uint32(clip.SampleRate))
+	b = binary.LittleEndian.AppendUint32(b, uint32(clip.SampleRate*clip.Channels*2))
+	b = binary.LittleEndian.AppendUint16(b, uint16(clip.Channels*2))
+	b = binary.LittleEndian.AppendUint16(b, 16)
+	b = append(b, "data"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(dataSize))
+
+	for _, s := range clip.Samples {
+		v := math.Max(-1, math.Min(1, float64(s)*volume))
+		b = binary.LittleEndian.AppendUint16(b, uint16(int16(math.Round(v*math.MaxInt16))))
+	}
+
+	return b
+}
@@ -0,0 +1,112 @@
+// Package status renders the current pomodoro on one line for status bars and shell prompts.
+package status
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"text/template"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+)
+
+// DefaultFormat is the template used when no format is given, e.g. "12:34 work (paused) write docs".
+const DefaultFormat = `{{.Remaining}}{{with .Phase}} {{.}}{{end}}` +
+	`{{if eq .State "paused"}} (paused){{end}}{{with .TaskTitle}} {{.}}{{end}}`
+
+// noRemaining is shown as the remaining time when no pomodoro is running or paused.
+const noRemaining = "--:--"
+
+const (
+	secondsPerMinute = 60
+	percent          = 100
+)
+
+// Status is the data a format template is executed with.
+// All fields are zero values, except Remaining, when there is no pomodoro.
+type Status struct {
+	// State is active, paused or finished.
+	State string
+	// Phase is work, short_break or long_break.
+	Phase string
+	// PhaseCount is the number of the phase since the cycle started.
+	PhaseCount int
+	// Cycle is the 1-based number of the work session within the long break cycle.
+	Cycle int
+	// BreakFrequency is the number of work sessions between long breaks.
+	BreakFrequency int
+	// Remaining is the remaining time as mm:ss, or "--:--" unless the pomodoro is running or paused.
+	Remaining    string
+	RemainingSec int
+	ElapsedSec   int
+	// Progress is the elapsed part of the phase in percent.
+	Progress  int
+	TaskID    string
+	TaskTitle string
+}
+
+// New returns the status of a pomodoro and its task, either of which may be nil.
+func New(pomodoro *core.Pomodoro, task *core.Task) Status {
+	s := Status{Remaining: noRemaining}
+
+	if pomodoro != nil {
+		s.State = string(pomodoro.State)
+		s.Phase = string(pomodoro.Phase)
+		s.PhaseCount = pomodoro.PhaseCount
+		s.Cycle = core.CyclePosition(pomodoro.PhaseCount, pomodoro.BreakFrequency)
+		s.BreakFrequency = pomodoro.BreakFrequency
+		s.Remaining = FormatRemaining(pomodoro)
+		s.RemainingSec = int(pomodoro.RemainingTime.Seconds())
+		s.ElapsedSec = int(pomodoro.ElapsedTime.Seconds())
+		s.TaskID = pomodoro.TaskID
+
+		if pomodoro.PhaseDuration > 0 {
+			s.Progress = min(int(pomodoro.ElapsedTime*percent/pomodoro.PhaseDuration), percent)
+		}
+	}
+
+	if task != nil {
+		s.TaskTitle = task.Title
+	}
+
+	return s
+}
+
+// FormatRemaining formats the remaining time of a running or paused pomodoro as mm:ss, and "--:--" otherwise.
+func FormatRemaining(pomodoro *core.Pomodoro) string {
+	if !slices.Contains([]event.PomodoroState{event.PomodoroStateActive, event.PomodoroStatePaused}, pomodoro.State) {
+		return noRemaining
+	}
+
+	sec := int(pomodoro.RemainingTime.Seconds())
+	minutes := sec / secondsPerMinute
+	seconds := sec % secondsPerMinute
+
+	return fmt.Sprintf("%02d:%02d", minutes, seconds)
+}
+
+// Template renders a Status with a text/template format.
+type Template struct {
+	tmpl *template.Template
+}
+
+// Parse parses a text/template format whose data is a Status.
+func Parse(format string) (*Template, error) {
+	tmpl, err := template.New("status").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse format: %w", err)
+	}
+
+	return &Template{tmpl: tmpl}, nil
+}
+
+// Render executes the template with s.
+func (t *Template) Render(s Status) (string, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, s); err != nil {
+		return "", fmt.Errorf("failed to render format: %w", err)
+	}
+
+	return buf.String(), nil
+}
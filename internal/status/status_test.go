@@ -0,0 +1,113 @@
+package status_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/status"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	pomodoro := &core.Pomodoro{
+		State:          event.PomodoroStatePaused,
+		Phase:          event.PomodoroPhaseWork,
+		PhaseCount:     3,
+		BreakFrequency: 4,
+		RemainingTime:  15 * time.Minute,
+		ElapsedTime:    10 * time.Minute,
+		PhaseDuration:  25 * time.Minute,
+		TaskID:         "a",
+	}
+	task := &core.Task{ID: "a", Title: "write docs"}
+
+	tests := []struct {
+		name     string
+		format   string
+		pomodoro *core.Pomodoro
+		task     *core.Task
+		want     string
+	}{
+		{
+			name:     "default",
+			format:   status.DefaultFormat,
+			pomodoro: pomodoro,
+			task:     task,
+			want:     "15:00 work (paused) write docs",
+		},
+		{
+			name:     "default without a task",
+			format:   status.DefaultFormat,
+			pomodoro: pomodoro,
+			want:     "15:00 work (paused)",
+		},
+		{
+			name:   "default without a pomodoro",
+			format: status.DefaultFormat,
+			want:   "--:--",
+		},
+		{
+			name:   "no pomodoro",
+			format: "{{.Remaining}}{{with .State}} {{.}}{{end}}",
+			want:   "--:--",
+		},
+		{
+			name: "all fields",
+			format: "{{.State}} {{.Phase}} #{{.PhaseCount}} {{.Cycle}}/{{.BreakFrequency}} " +
+				"{{.RemainingSec}}s {{.ElapsedSec}}s {{.Progress}}% {{.TaskID}}",
+			pomodoro: pomodoro,
+			task:     task,
+			want:     "paused work #3 2/4 900s 600s 40% a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := status.Parse(tt.format)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := tmpl.Render(status.New(tt.pomodoro, tt.task))
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderUnknownField(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := status.Parse("{{.Unknown}}")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if _, err := tmpl.Render(status.New(nil, nil)); err == nil {
+		t.Error("Render() with an unknown field succeeded, want error")
+	}
+}
+
+func TestFormatRemaining(t *testing.T) {
+	t.Parallel()
+
+	finished := &core.Pomodoro{State: event.PomodoroStateFinished, RemainingTime: time.Minute}
+	if got := status.FormatRemaining(finished); got != "--:--" {
+		t.Errorf("FormatRemaining(finished) = %q, want --:--", got)
+	}
+
+	active := &core.Pomodoro{State: event.PomodoroStateActive, RemainingTime: 61 * time.Minute}
+	if got := status.FormatRemaining(active); got != "61:00" {
+		t.Errorf("FormatRemaining(active) = %q, want 61:00", got)
+	}
+}
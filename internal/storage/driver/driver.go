@@ -0,0 +1,30 @@
+// Package driver opens the storage backend selected in the configuration
+package driver
+
+import (
+	"fmt"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/file"
+	"github.com/hatappi/gomodoro/internal/storage/sqlite"
+)
+
+// Open returns the storage.Storage implementation configured by storage.driver.
+//
+//nolint:ireturn
+func Open(storageCfg config.StorageConfig) (storage.Storage, error) {
+	switch storageCfg.Driver {
+	case config.StorageDriverFile, "":
+		return file.NewFileStorage(storageCfg), nil
+	case config.StorageDriverSQLite:
+		s, err := sqlite.NewSQLiteStorage(storageCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sqlite storage: %w", err)
+		}
+
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", storageCfg.Driver)
+	}
+}
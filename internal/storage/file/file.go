@@ -2,10 +2,15 @@
 package file
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -16,6 +21,11 @@ import (
 const (
 	// Default permissions for files.
 	filePermissions = 0o600
+
+	// eventLogMaxSize is the size of the event log file at which its oldest events are dropped,
+	// keeping the newest half so that a replay does not read an ever-growing file.
+	// A replay from before the kept events fails with core.ErrEventsDropped.
+	eventLogMaxSize = 8 << 20
 )
 
 // FileStorage implements storage.Storage using local JSON files.
@@ -23,10 +33,18 @@ const (
 //nolint:revive
 type FileStorage struct {
 	pomodoroFile string
+	historyFile  string
 	tasksFile    string
+	eventsFile   string
+	lettersFile  string
 	lockFile     string
 	lockHandle   *os.File // File handle for lock file
 	mu           sync.Mutex
+
+	// eventsSize and lastSequence cache the end of the event log file, so that an append does not read it.
+	// The cache is only used while the file has the size it had after the last write of this instance.
+	eventsSize   int64
+	lastSequence int64
 }
 
 // NewFileStorage creates a new file storage instance.
@@ -34,13 +52,20 @@ func NewFileStorage(storageCfg config.StorageConfig) *FileStorage {
 	baseDir := storageCfg.Dir
 
 	pomodoroFile := filepath.Join(baseDir, "pomodoro.json")
+	historyFile := filepath.Join(baseDir, "pomodoro_history.jsonl")
 	tasksFile := filepath.Join(baseDir, "tasks.json")
+	eventsFile := filepath.Join(baseDir, "events.jsonl")
+	lettersFile := filepath.Join(baseDir, "webhook_dead_letters.jsonl")
 	lockFile := filepath.Join(baseDir, "gomodoro.lock")
 
 	return &FileStorage{
 		pomodoroFile: pomodoroFile,
+		historyFile:  historyFile,
 		tasksFile:    tasksFile,
+		eventsFile:   eventsFile,
+		lettersFile:  lettersFile,
 		lockFile:     lockFile,
+		eventsSize:   -1,
 	}
 }
 
@@ -195,6 +220,7 @@ func (f *FileStorage) UpdatePomodoroState(
 	state storage.PomodoroState,
 	remainSec int,
 	elapsedSec int,
+	updatedAt time.Time,
 ) (*storage.Pomodoro, error) {
 	var pomodoro *storage.Pomodoro
 
@@ -219,6 +245,7 @@ func (f *FileStorage) UpdatePomodoroState(
 		pomodoro.State = state
 		pomodoro.RemainingTime = time.Duration(remainSec) * time.Second
 		pomodoro.ElapsedTime = time.Duration(elapsedSec) * time.Second
+		pomodoro.UpdatedAt = updatedAt
 
 		updatedData, err := json.MarshalIndent(pomodoro, "", "  ")
 		if err != nil {
@@ -267,6 +294,117 @@ func (f *FileStorage) DeletePomodoro(id string) error {
 	})
 }
 
+// AddPomodoroHistory appends a pomodoro session to the history file.
+// The history file is written in JSON Lines format so that appending never rewrites past sessions.
+func (f *FileStorage) AddPomodoroHistory(pomodoro *storage.Pomodoro) error {
+	return f.withFileLock(func() error {
+		data, err := json.Marshal(pomodoro)
+		if err != nil {
+			return fmt.Errorf("failed to marshal pomodoro: %w", err)
+		}
+
+		historyFile, err := os.OpenFile(f.historyFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermissions)
+		if err != nil {
+			return fmt.Errorf("failed to open pomodoro history file: %w", err)
+		}
+
+		if _, err := historyFile.Write(append(data, '\n')); err != nil {
+			_ = historyFile.Close()
+			return fmt.Errorf("failed to append pomodoro history: %w", err)
+		}
+
+		if err := historyFile.Close(); err != nil {
+			return fmt.Errorf("failed to close pomodoro history file: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetPomodoroHistory retrieves the sessions started within [start, end).
+func (f *FileStorage) GetPomodoroHistory(start, end time.Time) ([]*storage.Pomodoro, error) {
+	var pomodoros []*storage.Pomodoro
+
+	err := f.withFileLock(func() error {
+		history, err := f.readHistory()
+		if err != nil {
+			return err
+		}
+
+		pomodoros = make([]*storage.Pomodoro, 0, len(history))
+		for _, p := range history {
+			if !start.IsZero() && p.StartTime.Before(start) {
+				continue
+			}
+
+			if !end.IsZero() && !p.StartTime.Before(end) {
+				continue
+			}
+
+			pomodoros = append(pomodoros, p)
+		}
+
+		slices.SortStableFunc(pomodoros, storage.ComparePomodoros)
+
+		return nil
+	})
+
+	return pomodoros, err
+}
+
+// GetPomodoroHistoryByTaskID retrieves the sessions recorded for a task.
+func (f *FileStorage) GetPomodoroHistoryByTaskID(taskID string) ([]*storage.Pomodoro, error) {
+	var pomodoros []*storage.Pomodoro
+
+	err := f.withFileLock(func() error {
+		history, err := f.readHistory()
+		if err != nil {
+			return err
+		}
+
+		pomodoros = make([]*storage.Pomodoro, 0)
+		for _, p := range history {
+			if p.TaskID == taskID {
+				pomodoros = append(pomodoros, p)
+			}
+		}
+
+		slices.SortStableFunc(pomodoros, storage.ComparePomodoros)
+
+		return nil
+	})
+
+	return pomodoros, err
+}
+
+// GetPomodoroHistoryByID retrieves a session from the history by its ID.
+func (f *FileStorage) GetPomodoroHistoryByID(id string) (*storage.Pomodoro, error) {
+	var pomodoro *storage.Pomodoro
+
+	err := f.withFileLock(func() error {
+		history, err := f.readHistory()
+		if err != nil {
+			return err
+		}
+
+		pomodoro, err = storage.FindPomodoro(history, id)
+		return err
+	})
+
+	return pomodoro, err
+}
+
+// QueryPomodoroHistory retrieves the page of the history selected by the query.
+// The history file has to be read whole, so the query runs over every session in memory.
+func (f *FileStorage) QueryPomodoroHistory(query storage.PomodoroQuery) (*storage.PomodoroPage, error) {
+	history, err := f.GetPomodoroHistory(time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.PagePomodoros(history, query)
+}
+
 // SaveTask persists a task to the tasks file.
 func (f *FileStorage) SaveTask(task *storage.Task) error {
 	return f.withFileLock(func() error {
@@ -305,6 +443,17 @@ func (f *FileStorage) GetTasks() ([]*storage.Task, error) {
 	return tasks, err
 }
 
+// QueryTasks retrieves the page of tasks selected by the query.
+// The tasks file holds every task, so the query runs over all of them in memory.
+func (f *FileStorage) QueryTasks(query storage.TaskQuery) (*storage.TaskPage, error) {
+	tasks, err := f.GetTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.PageTasks(tasks, query)
+}
+
 // GetTaskByID retrieves a specific task by ID.
 func (f *FileStorage) GetTaskByID(id string) (*storage.Task, error) {
 	var foundTask *storage.Task
@@ -379,6 +528,181 @@ func (f *FileStorage) DeleteTask(id string) error {
 	})
 }
 
+// AppendEvent appends an event to the event log file with the next sequence number.
+// Like the history file, the event log file is written in JSON Lines format.
+func (f *FileStorage) AppendEvent(event *storage.Event) error {
+	return f.withFileLock(func() error {
+		size, sequence, err := f.eventLogEnd()
+		if err != nil {
+			return err
+		}
+
+		if size > eventLogMaxSize {
+			if err := f.compactEvents(); err != nil {
+				return err
+			}
+
+			size = f.eventsSize
+		}
+
+		record := *event
+		record.Sequence = sequence + 1
+
+		data, err := json.Marshal(&record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %w", err)
+		}
+
+		data = append(data, '\n')
+
+		eventsFile, err := os.OpenFile(f.eventsFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermissions)
+		if err != nil {
+			return fmt.Errorf("failed to open event log file: %w", err)
+		}
+
+		if _, err := eventsFile.Write(data); err != nil {
+			_ = eventsFile.Close()
+			f.eventsSize = -1
+			return fmt.Errorf("failed to append event: %w", err)
+		}
+
+		if err := eventsFile.Close(); err != nil {
+			f.eventsSize = -1
+			return fmt.Errorf("failed to close event log file: %w", err)
+		}
+
+		f.eventsSize = size + int64(len(data))
+		f.lastSequence = record.Sequence
+		event.Sequence = record.Sequence
+
+		return nil
+	})
+}
+
+// GetEventsSince retrieves the events with a sequence number greater than sequence.
+func (f *FileStorage) GetEventsSince(sequence int64) ([]*storage.Event, error) {
+	var events []*storage.Event
+
+	err := f.withFileLock(func() error {
+		all, err := f.readEvents()
+		if err != nil {
+			return err
+		}
+
+		events = make([]*storage.Event, 0)
+		for _, event := range all {
+			if event.Sequence > sequence {
+				events = append(events, event)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// AddDeadLetter appends a failed webhook delivery to the dead-letter file.
+// The file is written in JSON Lines format so that payloads are kept byte for byte,
+// and appending never rewrites the deliveries that are already queued.
+func (f *FileStorage) AddDeadLetter(letter *storage.DeadLetter) error {
+	return f.withFileLock(func() error {
+		data, err := json.Marshal(letter)
+		if err != nil {
+			return fmt.Errorf("failed to marshal dead letter: %w", err)
+		}
+
+		lettersFile, err := os.OpenFile(f.lettersFile, os.O_CREATE|os.O_APPEND|os.O_RDWR, filePermissions)
+		if err != nil {
+			return fmt.Errorf("failed to open dead-letter file: %w", err)
+		}
+
+		torn, err := endsWithoutNewline(lettersFile)
+		if err != nil {
+			_ = lettersFile.Close()
+			return err
+		}
+
+		// A crash during an earlier append can leave a torn line behind, which must not swallow this one.
+		if torn {
+			data = append([]byte{'\n'}, data...)
+		}
+
+		if _, err := lettersFile.Write(append(data, '\n')); err != nil {
+			_ = lettersFile.Close()
+			return fmt.Errorf("failed to append dead letter: %w", err)
+		}
+
+		if err := lettersFile.Close(); err != nil {
+			return fmt.Errorf("failed to close dead-letter file: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetDeadLetters retrieves the queued webhook deliveries, oldest first.
+func (f *FileStorage) GetDeadLetters() ([]*storage.DeadLetter, error) {
+	var letters []*storage.DeadLetter
+
+	err := f.withFileLock(func() error {
+		var err error
+		letters, err = f.readDeadLetters()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return letters, nil
+}
+
+// DeleteDeadLetter removes a webhook delivery from the dead-letter file.
+// The other lines are kept as they are, including damaged ones, and the file is replaced atomically,
+// so a failed write leaves the queue as it was.
+func (f *FileStorage) DeleteDeadLetter(id string) error {
+	return f.withFileLock(func() error {
+		var (
+			data  []byte
+			found bool
+		)
+
+		err := readLines(f.lettersFile, func(line []byte) error {
+			var l storage.DeadLetter
+			if err := json.Unmarshal(line, &l); err == nil && l.ID == id && !found {
+				found = true
+				return nil
+			}
+
+			data = append(append(data, line...), '\n')
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read dead-letter file: %w", err)
+		}
+
+		if !found {
+			return fmt.Errorf("dead letter with ID %s not found", id)
+		}
+
+		tmpFile := f.lettersFile + ".tmp"
+		if err := os.WriteFile(tmpFile, data, filePermissions); err != nil {
+			return fmt.Errorf("failed to write dead-letter file: %w", err)
+		}
+
+		if err := os.Rename(tmpFile, f.lettersFile); err != nil {
+			_ = os.Remove(tmpFile)
+			return fmt.Errorf("failed to replace dead-letter file: %w", err)
+		}
+
+		return nil
+	})
+}
+
 func (f *FileStorage) readTasks() ([]*storage.Task, error) {
 	if _, err := os.Stat(f.tasksFile); os.IsNotExist(err) {
 		return make([]*storage.Task, 0), nil
@@ -413,3 +737,192 @@ func (f *FileStorage) writeTasks(tasks []*storage.Task) error {
 
 	return nil
 }
+
+func (f *FileStorage) readHistory() ([]*storage.Pomodoro, error) {
+	pomodoros := make([]*storage.Pomodoro, 0)
+
+	err := readLines(f.historyFile, func(line []byte) error {
+		var p storage.Pomodoro
+		if err := json.Unmarshal(line, &p); err != nil {
+			return fmt.Errorf("failed to unmarshal pomodoro history: %w", err)
+		}
+
+		pomodoros = append(pomodoros, &p)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pomodoro history file: %w", err)
+	}
+
+	return pomodoros, nil
+}
+
+func (f *FileStorage) readEvents() ([]*storage.Event, error) {
+	events := make([]*storage.Event, 0)
+
+	err := readLines(f.eventsFile, func(line []byte) error {
+		var e storage.Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			return fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+
+		events = append(events, &e)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event log file: %w", err)
+	}
+
+	return events, nil
+}
+
+// eventLogEnd returns the size of the event log file and the sequence number of its last event.
+// The file is only read when it was changed by another process, or on the first append.
+func (f *FileStorage) eventLogEnd() (int64, int64, error) {
+	info, err := os.Stat(f.eventsFile)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to stat event log file: %w", err)
+	}
+
+	if info.Size() == f.eventsSize {
+		return f.eventsSize, f.lastSequence, nil
+	}
+
+	events, err := f.readEvents()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	f.eventsSize = info.Size()
+	f.lastSequence = 0
+
+	if len(events) > 0 {
+		f.lastSequence = events[len(events)-1].Sequence
+	}
+
+	return f.eventsSize, f.lastSequence, nil
+}
+
+// compactEvents drops the oldest events until the event log file is at most half of eventLogMaxSize.
+// The last event is always kept, so that sequence numbers continue after it.
+// The file is replaced atomically, so a failed compaction leaves the log as it was.
+func (f *FileStorage) compactEvents() error {
+	events, err := f.readEvents()
+	if err != nil {
+		return err
+	}
+
+	lines := make([][]byte, len(events))
+	for i, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %w", err)
+		}
+
+		lines[i] = append(line, '\n')
+	}
+
+	var size int64
+
+	keep := len(lines)
+	for keep > 0 && (keep == len(lines) || size+int64(len(lines[keep-1])) <= eventLogMaxSize/2) {
+		keep--
+		size += int64(len(lines[keep]))
+	}
+
+	tmpFile := f.eventsFile + ".tmp"
+	if err := os.WriteFile(tmpFile, bytes.Join(lines[keep:], nil), filePermissions); err != nil {
+		return fmt.Errorf("failed to write compacted event log file: %w", err)
+	}
+
+	if err := os.Rename(tmpFile, f.eventsFile); err != nil {
+		_ = os.Remove(tmpFile)
+		return fmt.Errorf("failed to replace event log file: %w", err)
+	}
+
+	f.eventsSize = size
+
+	return nil
+}
+
+// readDeadLetters reads the queued deliveries, skipping lines that cannot be parsed,
+// e.g. one torn by a crash during an append, so that one damaged line does not hide the whole queue.
+func (f *FileStorage) readDeadLetters() ([]*storage.DeadLetter, error) {
+	letters := make([]*storage.DeadLetter, 0)
+
+	err := readLines(f.lettersFile, func(line []byte) error {
+		var l storage.DeadLetter
+		if err := json.Unmarshal(line, &l); err != nil {
+			return nil //nolint:nilerr
+		}
+
+		letters = append(letters, &l)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dead-letter file: %w", err)
+	}
+
+	return letters, nil
+}
+
+// endsWithoutNewline reports whether a non-empty file does not end with a newline.
+func endsWithoutNewline(file *os.File) (bool, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	if info.Size() == 0 {
+		return false, nil
+	}
+
+	last := make([]byte, 1)
+	if _, err := file.ReadAt(last, info.Size()-1); err != nil {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return last[0] != '\n', nil
+}
+
+// readLines calls fn with each non-empty line of a JSON Lines file, which may not exist yet.
+// Unlike bufio.Scanner, it has no limit on the length of a line, e.g. a task event with long notes.
+func readLines(path string, fn func(line []byte) error) error {
+	file, err := os.Open(path) //nolint:gosec
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read line: %w", err)
+		}
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if fnErr := fn(line); fnErr != nil {
+				return fnErr
+			}
+		}
+
+		if err != nil {
+			return nil
+		}
+	}
+}
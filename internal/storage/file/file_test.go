@@ -0,0 +1,133 @@
+package file_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/file"
+	"github.com/hatappi/gomodoro/internal/storage/storagetest"
+)
+
+func TestFileStorage(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		t.Helper()
+
+		return file.NewFileStorage(config.StorageConfig{Dir: t.TempDir()})
+	})
+}
+
+func TestFileStorageEventLog(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.StorageConfig{Dir: t.TempDir()}
+	first := file.NewFileStorage(cfg)
+	second := file.NewFileStorage(cfg)
+
+	// 40 events of 256 KiB exceed the 8 MiB limit of the event log, so the oldest ones are dropped.
+	payload := json.RawMessage(`{"notes":"` + strings.Repeat("a", 256*1024) + `"}`)
+
+	for i := range 40 {
+		// Instances sharing a directory continue the sequence numbers of each other.
+		s := first
+		if i%3 == 0 {
+			s = second
+		}
+
+		e := &storage.Event{Type: "task.updated", Timestamp: time.Unix(1700000000, 0), Payload: payload}
+		if err := s.AppendEvent(e); err != nil {
+			t.Fatalf("AppendEvent() returned error: %v", err)
+		}
+
+		if want := int64(i + 1); e.Sequence != want {
+			t.Fatalf("AppendEvent() set sequence %d, want %d", e.Sequence, want)
+		}
+	}
+
+	events, err := first.GetEventsSince(0)
+	if err != nil {
+		t.Fatalf("GetEventsSince() returned error: %v", err)
+	}
+
+	if len(events) == 0 || len(events) >= 40 {
+		t.Fatalf("GetEventsSince(0) = %d events, want the newest events only", len(events))
+	}
+
+	for i, e := range events {
+		if want := int64(40 - len(events) + i + 1); e.Sequence != want {
+			t.Errorf("GetEventsSince(0)[%d].Sequence = %d, want %d", i, e.Sequence, want)
+		}
+	}
+
+	info, err := os.Stat(filepath.Join(cfg.Dir, "events.jsonl"))
+	if err != nil {
+		t.Fatalf("failed to stat event log file: %v", err)
+	}
+
+	if info.Size() > 8<<20 {
+		t.Errorf("event log file is %d bytes, want at most 8 MiB", info.Size())
+	}
+}
+
+func TestFileStorageDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.StorageConfig{Dir: t.TempDir()}
+	s := file.NewFileStorage(cfg)
+	path := filepath.Join(cfg.Dir, "webhook_dead_letters.jsonl")
+
+	if err := s.AddDeadLetter(&storage.DeadLetter{ID: "first", Payload: json.RawMessage(`{}`)}); err != nil {
+		t.Fatalf("AddDeadLetter() returned error: %v", err)
+	}
+
+	// A crash during an append leaves a torn line without a newline behind.
+	torn := `{"id":"torn","payl`
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("failed to open dead-letter file: %v", err)
+	}
+
+	if _, err := f.WriteString(torn); err != nil {
+		t.Fatalf("failed to write torn line: %v", err)
+	}
+
+	if err := f.Close(); err != nil {
+		t.Fatalf("failed to close dead-letter file: %v", err)
+	}
+
+	for _, id := range []string{"second", "third"} {
+		if err := s.AddDeadLetter(&storage.DeadLetter{ID: id, Payload: json.RawMessage(`{}`)}); err != nil {
+			t.Fatalf("AddDeadLetter() returned error: %v", err)
+		}
+	}
+
+	if err := s.DeleteDeadLetter("second"); err != nil {
+		t.Fatalf("DeleteDeadLetter() returned error: %v", err)
+	}
+
+	letters, err := s.GetDeadLetters()
+	if err != nil {
+		t.Fatalf("GetDeadLetters() returned error: %v", err)
+	}
+
+	if len(letters) != 2 || letters[0].ID != "first" || letters[1].ID != "third" {
+		t.Errorf("GetDeadLetters() = %+v, want first and third", letters)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read dead-letter file: %v", err)
+	}
+
+	if !strings.Contains(string(data), torn+"\n") {
+		t.Errorf("dead-letter file = %q, want the torn line to be kept", data)
+	}
+}
@@ -2,6 +2,7 @@
 package storage
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -17,6 +18,20 @@ const (
 	PomodoroStateFinished PomodoroState = "finished"
 )
 
+// TaskStatus represents the progress of a task.
+type TaskStatus string
+
+const (
+	// TaskStatusTodo indicates a task that has not been started.
+	TaskStatusTodo TaskStatus = "todo"
+	// TaskStatusInProgress indicates a task that is being worked on.
+	TaskStatusInProgress TaskStatus = "in_progress"
+	// TaskStatusDone indicates a finished task.
+	TaskStatusDone TaskStatus = "done"
+	// TaskStatusArchived indicates a task that is kept only for its history.
+	TaskStatusArchived TaskStatus = "archived"
+)
+
 // PomodoroPhase represents whether the current period is work or break.
 type PomodoroPhase string
 
@@ -34,6 +49,7 @@ type Pomodoro struct {
 	ID                string        `json:"id"`
 	State             PomodoroState `json:"state"`
 	StartTime         time.Time     `json:"start_time"`
+	EndTime           time.Time     `json:"end_time"`
 	WorkDuration      time.Duration `json:"work_duration"`
 	BreakDuration     time.Duration `json:"break_duration"`
 	LongBreakDuration time.Duration `json:"long_break_duration"`
@@ -42,14 +58,45 @@ type Pomodoro struct {
 	Phase             PomodoroPhase `json:"phase"`
 	PhaseDuration     time.Duration `json:"phase_duration"`
 	PhaseCount        int           `json:"phase_count"`
+	BreakFrequency    int           `json:"break_frequency"`
+	UpdatedAt         time.Time     `json:"updated_at"`
 	TaskID            string        `json:"task_id,omitempty"`
+	AutoAdvance       bool          `json:"auto_advance,omitempty"`
 }
 
 // Task represents a task that can be persisted.
 type Task struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"created_at"`
+	ID                 string     `json:"id"`
+	Title              string     `json:"title"`
+	Project            string     `json:"project,omitempty"`
+	Tags               []string   `json:"tags,omitempty"`
+	EstimatedPomodoros int        `json:"estimated_pomodoros,omitempty"`
+	Notes              string     `json:"notes,omitempty"`
+	Status             TaskStatus `json:"status,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+// Event represents a published event recorded in the event log.
+// Payload holds the JSON encoding of the whole event so that the storage does not depend on the event types.
+type Event struct {
+	Sequence  int64           `json:"sequence"`
+	Type      string          `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// DeadLetter represents a webhook delivery that failed after all attempts.
+type DeadLetter struct {
+	ID      string `json:"id"`
+	Webhook string `json:"webhook"`
+	// DeliveryID is the ID the failed attempts were sent with, so a retry can be deduplicated by the receiver.
+	DeliveryID string          `json:"delivery_id"`
+	URL        string          `json:"url"`
+	EventType  string          `json:"event_type"`
+	Payload    json.RawMessage `json:"payload"`
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"last_error"`
+	CreatedAt  time.Time       `json:"created_at"`
 }
 
 // PomodoroStorage defines the interface for pomodoro persistence operations.
@@ -63,11 +110,33 @@ type PomodoroStorage interface {
 	// GetActivePomodoro retrieves the current active pomodoro session if any
 	GetActivePomodoro() (*Pomodoro, error)
 
-	// UpdatePomodoroState updates the state and remaining time of a pomodoro
-	UpdatePomodoroState(id string, state PomodoroState, remainSec int, elapsedSec int) (*Pomodoro, error)
+	// UpdatePomodoroState updates the state and remaining time of a pomodoro and stamps UpdatedAt with updatedAt
+	UpdatePomodoroState(
+		id string,
+		state PomodoroState,
+		remainSec int,
+		elapsedSec int,
+		updatedAt time.Time,
+	) (*Pomodoro, error)
 
 	// DeletePomodoro deletes a pomodoro session by ID
 	DeletePomodoro(id string) error
+
+	// AddPomodoroHistory appends a finished or stopped pomodoro session to the history
+	AddPomodoroHistory(pomodoro *Pomodoro) error
+
+	// GetPomodoroHistory retrieves the sessions started within [start, end) in the order of ComparePomodoros.
+	// A zero start or end leaves that side of the range open.
+	GetPomodoroHistory(start, end time.Time) ([]*Pomodoro, error)
+
+	// GetPomodoroHistoryByTaskID retrieves the sessions recorded for a task in the order of ComparePomodoros
+	GetPomodoroHistoryByTaskID(taskID string) ([]*Pomodoro, error)
+
+	// GetPomodoroHistoryByID retrieves a session from the history by its ID
+	GetPomodoroHistoryByID(id string) (*Pomodoro, error)
+
+	// QueryPomodoroHistory retrieves the page of the history selected by the query
+	QueryPomodoroHistory(query PomodoroQuery) (*PomodoroPage, error)
 }
 
 // TaskStorage defines the interface for task persistence operations.
@@ -78,6 +147,9 @@ type TaskStorage interface {
 	// GetTasks retrieves all tasks
 	GetTasks() ([]*Task, error)
 
+	// QueryTasks retrieves the page of tasks selected by the query
+	QueryTasks(query TaskQuery) (*TaskPage, error)
+
 	// GetTaskByID retrieves a task by its ID
 	GetTaskByID(id string) (*Task, error)
 
@@ -88,8 +160,33 @@ type TaskStorage interface {
 	DeleteTask(id string) error
 }
 
+// EventStorage defines the interface for event log persistence operations.
+type EventStorage interface {
+	// AppendEvent stores an event with the next sequence number and sets it on the event.
+	// Sequence numbers start at 1 and increase monotonically.
+	AppendEvent(event *Event) error
+
+	// GetEventsSince retrieves the events with a sequence number greater than sequence in order.
+	// A storage may drop the oldest events to bound the log, but it always keeps the last one.
+	GetEventsSince(sequence int64) ([]*Event, error)
+}
+
+// DeadLetterStorage defines the interface for the webhook dead-letter queue.
+type DeadLetterStorage interface {
+	// AddDeadLetter appends a failed delivery to the queue
+	AddDeadLetter(letter *DeadLetter) error
+
+	// GetDeadLetters retrieves the queued deliveries, oldest first
+	GetDeadLetters() ([]*DeadLetter, error)
+
+	// DeleteDeadLetter removes a delivery from the queue by its ID
+	DeleteDeadLetter(id string) error
+}
+
 // Storage is the combined interface for all storage operations.
 type Storage interface {
 	PomodoroStorage
 	TaskStorage
+	EventStorage
+	DeadLetterStorage
 }
@@ -0,0 +1,366 @@
+// Package memory provides an in-memory implementation of the storage interfaces
+package memory
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+// MemoryStorage implements storage.Storage in memory.
+// Values are copied on the way in and out, so callers never share state with the storage
+// in the same way as with the persistent implementations.
+//
+//nolint:revive
+type MemoryStorage struct {
+	mu       sync.Mutex
+	pomodoro *storage.Pomodoro
+	history  []*storage.Pomodoro
+	tasks    []*storage.Task
+	events   []*storage.Event
+	letters  []*storage.DeadLetter
+}
+
+// NewMemoryStorage creates a new empty in-memory storage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{}
+}
+
+// SavePomodoro stores a pomodoro as the current session.
+func (m *MemoryStorage) SavePomodoro(pomodoro *storage.Pomodoro) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pomodoro = copyPomodoro(pomodoro)
+
+	return nil
+}
+
+// GetLatestPomodoro retrieves the latest pomodoro.
+func (m *MemoryStorage) GetLatestPomodoro() (*storage.Pomodoro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return copyPomodoro(m.pomodoro), nil
+}
+
+// GetActivePomodoro retrieves the current active pomodoro.
+func (m *MemoryStorage) GetActivePomodoro() (*storage.Pomodoro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.pomodoro == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	if m.pomodoro.State != storage.PomodoroStateActive && m.pomodoro.State != storage.PomodoroStatePaused {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	return copyPomodoro(m.pomodoro), nil
+}
+
+// UpdatePomodoroState updates the state and remaining time of a pomodoro.
+func (m *MemoryStorage) UpdatePomodoroState(
+	id string,
+	state storage.PomodoroState,
+	remainSec int,
+	elapsedSec int,
+	updatedAt time.Time,
+) (*storage.Pomodoro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.pomodoro == nil {
+		return nil, fmt.Errorf("no active pomodoro found")
+	}
+
+	if m.pomodoro.ID != id {
+		return nil, fmt.Errorf("pomodoro ID mismatch")
+	}
+
+	m.pomodoro.State = state
+	m.pomodoro.RemainingTime = time.Duration(remainSec) * time.Second
+	m.pomodoro.ElapsedTime = time.Duration(elapsedSec) * time.Second
+	m.pomodoro.UpdatedAt = updatedAt
+
+	return copyPomodoro(m.pomodoro), nil
+}
+
+// DeletePomodoro deletes the current session if the ID matches.
+func (m *MemoryStorage) DeletePomodoro(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.pomodoro != nil && m.pomodoro.ID == id {
+		m.pomodoro = nil
+	}
+
+	return nil
+}
+
+// AddPomodoroHistory appends a pomodoro session to the history.
+func (m *MemoryStorage) AddPomodoroHistory(pomodoro *storage.Pomodoro) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.history = append(m.history, copyPomodoro(pomodoro))
+
+	return nil
+}
+
+// GetPomodoroHistory retrieves the sessions started within [start, end).
+func (m *MemoryStorage) GetPomodoroHistory(start, end time.Time) ([]*storage.Pomodoro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pomodoros := make([]*storage.Pomodoro, 0, len(m.history))
+	for _, p := range m.history {
+		if !start.IsZero() && p.StartTime.Before(start) {
+			continue
+		}
+
+		if !end.IsZero() && !p.StartTime.Before(end) {
+			continue
+		}
+
+		pomodoros = append(pomodoros, copyPomodoro(p))
+	}
+
+	slices.SortStableFunc(pomodoros, storage.ComparePomodoros)
+
+	return pomodoros, nil
+}
+
+// GetPomodoroHistoryByTaskID retrieves the sessions recorded for a task.
+func (m *MemoryStorage) GetPomodoroHistoryByTaskID(taskID string) ([]*storage.Pomodoro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pomodoros := make([]*storage.Pomodoro, 0)
+	for _, p := range m.history {
+		if p.TaskID == taskID {
+			pomodoros = append(pomodoros, copyPomodoro(p))
+		}
+	}
+
+	slices.SortStableFunc(pomodoros, storage.ComparePomodoros)
+
+	return pomodoros, nil
+}
+
+// GetPomodoroHistoryByID retrieves a session from the history by its ID.
+func (m *MemoryStorage) GetPomodoroHistoryByID(id string) (*storage.Pomodoro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pomodoro, err := storage.FindPomodoro(m.history, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyPomodoro(pomodoro), nil
+}
+
+// QueryPomodoroHistory retrieves the page of the history selected by the query.
+func (m *MemoryStorage) QueryPomodoroHistory(query storage.PomodoroQuery) (*storage.PomodoroPage, error) {
+	history, err := m.GetPomodoroHistory(time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.PagePomodoros(history, query)
+}
+
+// SaveTask stores a task, replacing the task with the same ID if any.
+func (m *MemoryStorage) SaveTask(task *storage.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if i := m.taskIndex(task.ID); i >= 0 {
+		m.tasks[i] = copyTask(task)
+		return nil
+	}
+
+	m.tasks = append(m.tasks, copyTask(task))
+
+	return nil
+}
+
+// GetTasks retrieves all tasks.
+func (m *MemoryStorage) GetTasks() ([]*storage.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tasks := make([]*storage.Task, len(m.tasks))
+	for i, task := range m.tasks {
+		tasks[i] = copyTask(task)
+	}
+
+	return tasks, nil
+}
+
+// QueryTasks retrieves the page of tasks selected by the query.
+func (m *MemoryStorage) QueryTasks(query storage.TaskQuery) (*storage.TaskPage, error) {
+	tasks, err := m.GetTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.PageTasks(tasks, query)
+}
+
+// GetTaskByID retrieves a specific task by ID.
+func (m *MemoryStorage) GetTaskByID(id string) (*storage.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.taskIndex(id)
+	if i < 0 {
+		return nil, fmt.Errorf("task with ID %s not found", id)
+	}
+
+	return copyTask(m.tasks[i]), nil
+}
+
+// UpdateTask updates an existing task.
+func (m *MemoryStorage) UpdateTask(task *storage.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.taskIndex(task.ID)
+	if i < 0 {
+		return fmt.Errorf("task with ID %s not found", task.ID)
+	}
+
+	m.tasks[i] = copyTask(task)
+
+	return nil
+}
+
+// DeleteTask removes a task by ID.
+func (m *MemoryStorage) DeleteTask(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.taskIndex(id)
+	if i < 0 {
+		return fmt.Errorf("task with ID %s not found", id)
+	}
+
+	m.tasks = append(m.tasks[:i], m.tasks[i+1:]...)
+
+	return nil
+}
+
+// AppendEvent stores an event with the next sequence number.
+func (m *MemoryStorage) AppendEvent(event *storage.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	event.Sequence = int64(len(m.events)) + 1
+	m.events = append(m.events, copyEvent(event))
+
+	return nil
+}
+
+// GetEventsSince retrieves the events with a sequence number greater than sequence.
+func (m *MemoryStorage) GetEventsSince(sequence int64) ([]*storage.Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]*storage.Event, 0)
+	for _, event := range m.events[min(max(sequence, 0), int64(len(m.events))):] {
+		events = append(events, copyEvent(event))
+	}
+
+	return events, nil
+}
+
+// AddDeadLetter appends a failed webhook delivery to the queue.
+func (m *MemoryStorage) AddDeadLetter(letter *storage.DeadLetter) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.letters = append(m.letters, copyDeadLetter(letter))
+
+	return nil
+}
+
+// GetDeadLetters retrieves the queued webhook deliveries, oldest first.
+func (m *MemoryStorage) GetDeadLetters() ([]*storage.DeadLetter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	letters := make([]*storage.DeadLetter, len(m.letters))
+	for i, letter := range m.letters {
+		letters[i] = copyDeadLetter(letter)
+	}
+
+	return letters, nil
+}
+
+// DeleteDeadLetter removes a webhook delivery from the queue.
+func (m *MemoryStorage) DeleteDeadLetter(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := slices.IndexFunc(m.letters, func(letter *storage.DeadLetter) bool { return letter.ID == id })
+	if i < 0 {
+		return fmt.Errorf("dead letter with ID %s not found", id)
+	}
+
+	m.letters = slices.Delete(m.letters, i, i+1)
+
+	return nil
+}
+
+func (m *MemoryStorage) taskIndex(id string) int {
+	for i, task := range m.tasks {
+		if task.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func copyPomodoro(p *storage.Pomodoro) *storage.Pomodoro {
+	if p == nil {
+		return nil
+	}
+
+	c := *p
+
+	return &c
+}
+
+func copyTask(t *storage.Task) *storage.Task {
+	if t == nil {
+		return nil
+	}
+
+	c := *t
+	c.Tags = slices.Clone(t.Tags)
+
+	return &c
+}
+
+func copyEvent(e *storage.Event) *storage.Event {
+	c := *e
+	c.Payload = slices.Clone(e.Payload)
+
+	return &c
+}
+
+func copyDeadLetter(l *storage.DeadLetter) *storage.DeadLetter {
+	c := *l
+	c.Payload = slices.Clone(l.Payload)
+
+	return &c
+}
@@ -0,0 +1,19 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+	"github.com/hatappi/gomodoro/internal/storage/storagetest"
+)
+
+func TestMemoryStorage(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		t.Helper()
+
+		return memory.NewMemoryStorage()
+	})
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PomodoroQuery selects a page of the pomodoro history.
+// Sessions are ordered chronologically by start time, with ties broken by ID.
+type PomodoroQuery struct {
+	// TaskID matches the sessions recorded for the task.
+	TaskID string
+	// Phases matches sessions in any of the phases. Empty matches every phase.
+	Phases []PomodoroPhase
+	// StartedAfter and StartedBefore match sessions started within [StartedAfter, StartedBefore).
+	// A zero time leaves that side of the range open.
+	StartedAfter  time.Time
+	StartedBefore time.Time
+
+	// After is the ID of the session bounding the page, exclusively.
+	// The bounding session itself does not have to match the filters.
+	After string
+	// First keeps only the first sessions after the bound. Zero means no limit.
+	First int
+}
+
+// PomodoroPage is a page of the pomodoro history selected by a PomodoroQuery.
+type PomodoroPage struct {
+	Pomodoros []*Pomodoro
+	// TotalCount is the number of sessions matching the filters, regardless of the page bounds.
+	TotalCount int
+	// HasPreviousPage and HasNextPage report whether matching sessions exist before and after the page.
+	HasPreviousPage bool
+	HasNextPage     bool
+}
+
+// Validate reports whether the query can be run.
+func (q PomodoroQuery) Validate() error {
+	if q.First < 0 {
+		return fmt.Errorf("page size cannot be negative")
+	}
+
+	return nil
+}
+
+// Matches reports whether the session passes the filters of the query.
+func (q PomodoroQuery) Matches(p *Pomodoro) bool {
+	if q.TaskID != "" && p.TaskID != q.TaskID {
+		return false
+	}
+
+	if len(q.Phases) > 0 && !slices.Contains(q.Phases, p.Phase) {
+		return false
+	}
+
+	if !q.StartedAfter.IsZero() && p.StartTime.Before(q.StartedAfter) {
+		return false
+	}
+
+	if !q.StartedBefore.IsZero() && !p.StartTime.Before(q.StartedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// ComparePomodoros orders two sessions by start time and then by ID.
+func ComparePomodoros(a, b *Pomodoro) int {
+	return cmp.Or(a.StartTime.Compare(b.StartTime), strings.Compare(a.ID, b.ID))
+}
+
+// PagePomodoros runs the query over the whole history in memory.
+// It serves the storages that have to load the history anyway.
+func PagePomodoros(history []*Pomodoro, q PomodoroQuery) (*PomodoroPage, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	matched := make([]*Pomodoro, 0, len(history))
+	for _, p := range history {
+		if q.Matches(p) {
+			matched = append(matched, p)
+		}
+	}
+
+	slices.SortFunc(matched, ComparePomodoros)
+
+	start := 0
+
+	if q.After != "" {
+		after, err := FindPomodoro(history, q.After)
+		if err != nil {
+			return nil, err
+		}
+
+		start, _ = slices.BinarySearchFunc(matched, after, ComparePomodoros)
+		if start < len(matched) && matched[start].ID == after.ID {
+			start++
+		}
+	}
+
+	start, end := PageBounds(start, len(matched), len(matched), q.First, 0)
+
+	return &PomodoroPage{
+		Pomodoros:       matched[start:end],
+		TotalCount:      len(matched),
+		HasPreviousPage: start > 0,
+		HasNextPage:     end < len(matched),
+	}, nil
+}
+
+// FindPomodoro returns the session with the ID from the history.
+func FindPomodoro(history []*Pomodoro, id string) (*Pomodoro, error) {
+	for _, p := range history {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("pomodoro with ID %s not found", id)
+}
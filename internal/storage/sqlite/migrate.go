@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// migrations holds the schema changes in the order they are applied.
+// The index of the last applied migration plus one is kept in PRAGMA user_version,
+// so existing entries must never be edited; append a new entry instead.
+var migrations = []string{
+	`
+	CREATE TABLE current_pomodoro (
+		id                  TEXT PRIMARY KEY,
+		state               TEXT NOT NULL,
+		start_time          INTEGER NOT NULL,
+		end_time            INTEGER NOT NULL DEFAULT 0,
+		work_duration       INTEGER NOT NULL,
+		break_duration      INTEGER NOT NULL,
+		long_break_duration INTEGER NOT NULL,
+		remaining_time      INTEGER NOT NULL,
+		elapsed_time        INTEGER NOT NULL,
+		phase               TEXT NOT NULL,
+		phase_duration      INTEGER NOT NULL,
+		phase_count         INTEGER NOT NULL,
+		task_id             TEXT NOT NULL DEFAULT ''
+	);
+
+	CREATE TABLE pomodoro_history (
+		seq                 INTEGER PRIMARY KEY,
+		id                  TEXT NOT NULL,
+		state               TEXT NOT NULL,
+		start_time          INTEGER NOT NULL,
+		end_time            INTEGER NOT NULL DEFAULT 0,
+		work_duration       INTEGER NOT NULL,
+		break_duration      INTEGER NOT NULL,
+		long_break_duration INTEGER NOT NULL,
+		remaining_time      INTEGER NOT NULL,
+		elapsed_time        INTEGER NOT NULL,
+		phase               TEXT NOT NULL,
+		phase_duration      INTEGER NOT NULL,
+		phase_count         INTEGER NOT NULL,
+		task_id             TEXT NOT NULL DEFAULT ''
+	);
+
+	CREATE INDEX pomodoro_history_start_time ON pomodoro_history (start_time);
+	CREATE INDEX pomodoro_history_task_id ON pomodoro_history (task_id);
+
+	CREATE TABLE tasks (
+		seq        INTEGER PRIMARY KEY,
+		id         TEXT NOT NULL UNIQUE,
+		title      TEXT NOT NULL,
+		created_at INTEGER NOT NULL
+	);
+	`,
+	`
+	ALTER TABLE current_pomodoro ADD COLUMN break_frequency INTEGER NOT NULL DEFAULT 0;
+	ALTER TABLE pomodoro_history ADD COLUMN break_frequency INTEGER NOT NULL DEFAULT 0;
+	`,
+	`
+	ALTER TABLE current_pomodoro ADD COLUMN updated_at INTEGER NOT NULL DEFAULT 0;
+	ALTER TABLE pomodoro_history ADD COLUMN updated_at INTEGER NOT NULL DEFAULT 0;
+	`,
+	`
+	ALTER TABLE tasks ADD COLUMN project TEXT NOT NULL DEFAULT '';
+	ALTER TABLE tasks ADD COLUMN tags TEXT NOT NULL DEFAULT '[]';
+	ALTER TABLE tasks ADD COLUMN estimated_pomodoros INTEGER NOT NULL DEFAULT 0;
+	ALTER TABLE tasks ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+	`,
+	`
+	ALTER TABLE tasks ADD COLUMN status TEXT NOT NULL DEFAULT 'todo';
+	`,
+	`
+	CREATE INDEX tasks_created_at ON tasks (created_at, id);
+	CREATE INDEX tasks_title ON tasks (title, id);
+	`,
+	`
+	CREATE INDEX pomodoro_history_start_time_id ON pomodoro_history (start_time, id);
+	`,
+	`
+	ALTER TABLE current_pomodoro ADD COLUMN auto_advance INTEGER NOT NULL DEFAULT 0;
+	ALTER TABLE pomodoro_history ADD COLUMN auto_advance INTEGER NOT NULL DEFAULT 0;
+	`,
+	`
+	CREATE TABLE events (
+		sequence  INTEGER PRIMARY KEY AUTOINCREMENT,
+		type      TEXT NOT NULL,
+		timestamp INTEGER NOT NULL,
+		payload   TEXT NOT NULL
+	);
+	`,
+	`
+	CREATE TABLE webhook_dead_letters (
+		seq        INTEGER PRIMARY KEY,
+		id         TEXT NOT NULL UNIQUE,
+		webhook    TEXT NOT NULL,
+		url        TEXT NOT NULL,
+		event_type TEXT NOT NULL,
+		payload    TEXT NOT NULL,
+		attempts   INTEGER NOT NULL,
+		last_error TEXT NOT NULL,
+		created_at INTEGER NOT NULL
+	);
+	`,
+	`
+	ALTER TABLE webhook_dead_letters ADD COLUMN delivery_id TEXT NOT NULL DEFAULT '';
+	`,
+}
+
+// migrate applies the migrations that have not been applied to the database yet.
+func (s *SQLiteStorage) migrate() error {
+	var version int
+	if err := s.db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		return fmt.Errorf("failed to get schema version: %w", err)
+	}
+
+	if version > len(migrations) {
+		return fmt.Errorf("database schema version %d is newer than supported version %d", version, len(migrations))
+	}
+
+	for i := version; i < len(migrations); i++ {
+		err := s.withTx(func(tx *sql.Tx) error {
+			if _, err := tx.Exec(migrations[i]); err != nil {
+				return fmt.Errorf("failed to apply migration %d: %w", i+1, err)
+			}
+
+			// PRAGMA statements do not accept bound parameters.
+			if _, err := tx.Exec(fmt.Sprintf(`PRAGMA user_version = %d`, i+1)); err != nil {
+				return fmt.Errorf("failed to update schema version: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
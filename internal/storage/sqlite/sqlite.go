@@ -0,0 +1,914 @@
+// Package sqlite provides an embedded SQLite implementation of the storage interfaces
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	// register the pure Go SQLite driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+const (
+	// databaseFile is the name of the database file within the storage directory.
+	databaseFile = "gomodoro.db"
+
+	// busyTimeoutMillis is how long a connection waits for a lock held by another process.
+	busyTimeoutMillis = 5000
+
+	pomodoroColumns = `id, state, start_time, end_time, work_duration, break_duration, long_break_duration,
+		remaining_time, elapsed_time, phase, phase_duration, phase_count, task_id, break_frequency, updated_at,
+		auto_advance`
+
+	taskColumns = `id, title, project, tags, estimated_pomodoros, notes, status, created_at`
+
+	deadLetterColumns = `id, webhook, delivery_id, url, event_type, payload, attempts, last_error, created_at`
+)
+
+// SQLiteStorage implements storage.Storage using an embedded SQLite database.
+//
+//nolint:revive
+type SQLiteStorage struct {
+	db *sql.DB
+}
+
+// NewSQLiteStorage opens the database under the storage directory and applies pending migrations.
+func NewSQLiteStorage(storageCfg config.StorageConfig) (*SQLiteStorage, error) {
+	return Open(filepath.Join(storageCfg.Dir, databaseFile))
+}
+
+// Open opens the database at the given path and applies pending migrations.
+func Open(path string) (*SQLiteStorage, error) {
+	query := url.Values{}
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeoutMillis))
+	query.Add("_pragma", "journal_mode(WAL)")
+
+	db, err := sql.Open("sqlite", "file:"+path+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// A single connection serializes writers within the process and keeps
+	// the in-process view of the database consistent.
+	db.SetMaxOpenConns(1)
+
+	s := &SQLiteStorage{db: db}
+
+	if err := s.migrate(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close closes the underlying database.
+func (s *SQLiteStorage) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("failed to close database: %w", err)
+	}
+
+	return nil
+}
+
+// SavePomodoro replaces the current pomodoro session.
+func (s *SQLiteStorage) SavePomodoro(pomodoro *storage.Pomodoro) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM current_pomodoro`); err != nil {
+			return fmt.Errorf("failed to clear current pomodoro: %w", err)
+		}
+
+		if err := insertPomodoro(tx, "current_pomodoro", pomodoro); err != nil {
+			return fmt.Errorf("failed to save pomodoro: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetLatestPomodoro retrieves the current pomodoro session.
+func (s *SQLiteStorage) GetLatestPomodoro() (*storage.Pomodoro, error) {
+	row := s.db.QueryRow(`SELECT ` + pomodoroColumns + ` FROM current_pomodoro LIMIT 1`)
+
+	pomodoro, err := scanPomodoro(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest pomodoro: %w", err)
+	}
+
+	return pomodoro, nil
+}
+
+// GetActivePomodoro retrieves the current pomodoro session if it is active or paused.
+func (s *SQLiteStorage) GetActivePomodoro() (*storage.Pomodoro, error) {
+	row := s.db.QueryRow(
+		`SELECT `+pomodoroColumns+` FROM current_pomodoro WHERE state IN (?, ?) LIMIT 1`,
+		storage.PomodoroStateActive,
+		storage.PomodoroStatePaused,
+	)
+
+	pomodoro, err := scanPomodoro(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active pomodoro: %w", err)
+	}
+
+	return pomodoro, nil
+}
+
+// UpdatePomodoroState updates the state and remaining time of the current pomodoro.
+func (s *SQLiteStorage) UpdatePomodoroState(
+	id string,
+	state storage.PomodoroState,
+	remainSec int,
+	elapsedSec int,
+	updatedAt time.Time,
+) (*storage.Pomodoro, error) {
+	var pomodoro *storage.Pomodoro
+
+	err := s.withTx(func(tx *sql.Tx) error {
+		res, err := tx.Exec(
+			`UPDATE current_pomodoro SET state = ?, remaining_time = ?, elapsed_time = ?, updated_at = ? WHERE id = ?`,
+			state,
+			int64(time.Duration(remainSec)*time.Second),
+			int64(time.Duration(elapsedSec)*time.Second),
+			toUnixNano(updatedAt),
+			id,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update pomodoro state: %w", err)
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to update pomodoro state: %w", err)
+		}
+
+		if affected == 0 {
+			return fmt.Errorf("pomodoro with ID %s not found", id)
+		}
+
+		row := tx.QueryRow(`SELECT `+pomodoroColumns+` FROM current_pomodoro WHERE id = ?`, id)
+
+		pomodoro, err = scanPomodoro(row)
+		if err != nil {
+			return fmt.Errorf("failed to get updated pomodoro: %w", err)
+		}
+
+		return nil
+	})
+
+	return pomodoro, err
+}
+
+// DeletePomodoro deletes the current pomodoro session if the ID matches.
+func (s *SQLiteStorage) DeletePomodoro(id string) error {
+	if _, err := s.db.Exec(`DELETE FROM current_pomodoro WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete pomodoro: %w", err)
+	}
+
+	return nil
+}
+
+// AddPomodoroHistory appends a pomodoro session to the history.
+func (s *SQLiteStorage) AddPomodoroHistory(pomodoro *storage.Pomodoro) error {
+	if err := insertPomodoro(s.db, "pomodoro_history", pomodoro); err != nil {
+		return fmt.Errorf("failed to add pomodoro history: %w", err)
+	}
+
+	return nil
+}
+
+// GetPomodoroHistory retrieves the sessions started within [start, end).
+func (s *SQLiteStorage) GetPomodoroHistory(start, end time.Time) ([]*storage.Pomodoro, error) {
+	query := `SELECT ` + pomodoroColumns + ` FROM pomodoro_history WHERE 1 = 1`
+	args := []any{}
+
+	if !start.IsZero() {
+		query += ` AND start_time >= ?`
+		args = append(args, toUnixNano(start))
+	}
+
+	if !end.IsZero() {
+		query += ` AND start_time < ?`
+		args = append(args, toUnixNano(end))
+	}
+
+	query += ` ORDER BY start_time, id`
+
+	return s.queryPomodoros(query, args...)
+}
+
+// GetPomodoroHistoryByTaskID retrieves the sessions recorded for a task.
+func (s *SQLiteStorage) GetPomodoroHistoryByTaskID(taskID string) ([]*storage.Pomodoro, error) {
+	return s.queryPomodoros(
+		`SELECT `+pomodoroColumns+` FROM pomodoro_history WHERE task_id = ? ORDER BY start_time, id`,
+		taskID,
+	)
+}
+
+// GetPomodoroHistoryByID retrieves a session from the history by its ID.
+func (s *SQLiteStorage) GetPomodoroHistoryByID(id string) (*storage.Pomodoro, error) {
+	row := s.db.QueryRow(`SELECT `+pomodoroColumns+` FROM pomodoro_history WHERE id = ? ORDER BY seq LIMIT 1`, id)
+
+	pomodoro, err := scanPomodoro(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("pomodoro with ID %s not found", id)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	return pomodoro, nil
+}
+
+// QueryPomodoroHistory retrieves the page of the history selected by the query.
+// Like QueryTasks, only the sessions on the page are loaded.
+func (s *SQLiteStorage) QueryPomodoroHistory(query storage.PomodoroQuery) (*storage.PomodoroPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	where, args := pomodoroFilter(query)
+
+	total, err := s.countPomodoros(where, args)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+
+	if query.After != "" {
+		var startTime int64
+		err := s.db.QueryRow(
+			`SELECT start_time FROM pomodoro_history WHERE id = ? ORDER BY seq LIMIT 1`,
+			query.After,
+		).Scan(&startTime)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("pomodoro with ID %s not found", query.After)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+		}
+
+		start, err = s.countPomodoros(
+			andWhere(where, `(start_time, id) <= (?, ?)`),
+			append(slices.Clone(args), startTime, query.After),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start, end := storage.PageBounds(start, total, total, query.First, 0)
+
+	pomodoros, err := s.queryPomodoros(
+		`SELECT `+pomodoroColumns+` FROM pomodoro_history `+where+` ORDER BY start_time, id LIMIT ? OFFSET ?`,
+		append(slices.Clone(args), end-start, start)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.PomodoroPage{
+		Pomodoros:       pomodoros,
+		TotalCount:      total,
+		HasPreviousPage: start > 0,
+		HasNextPage:     end < total,
+	}, nil
+}
+
+func (s *SQLiteStorage) countPomodoros(where string, args []any) (int, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM pomodoro_history `+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count pomodoro history: %w", err)
+	}
+
+	return count, nil
+}
+
+// pomodoroFilter builds the WHERE clause matching the filters of the query.
+func pomodoroFilter(query storage.PomodoroQuery) (string, []any) {
+	var (
+		where string
+		args  []any
+	)
+
+	if query.TaskID != "" {
+		where = andWhere(where, `task_id = ?`)
+		args = append(args, query.TaskID)
+	}
+
+	if len(query.Phases) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(query.Phases)), ", ")
+		where = andWhere(where, `phase IN (`+placeholders+`)`)
+
+		for _, phase := range query.Phases {
+			args = append(args, phase)
+		}
+	}
+
+	if !query.StartedAfter.IsZero() {
+		where = andWhere(where, `start_time >= ?`)
+		args = append(args, toUnixNano(query.StartedAfter))
+	}
+
+	if !query.StartedBefore.IsZero() {
+		where = andWhere(where, `start_time < ?`)
+		args = append(args, toUnixNano(query.StartedBefore))
+	}
+
+	return where, args
+}
+
+// SaveTask inserts a task or replaces the task with the same ID.
+func (s *SQLiteStorage) SaveTask(task *storage.Task) error {
+	args, err := taskArgs(task)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(
+		`INSERT INTO tasks (`+taskColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			title = excluded.title,
+			project = excluded.project,
+			tags = excluded.tags,
+			estimated_pomodoros = excluded.estimated_pomodoros,
+			notes = excluded.notes,
+			status = excluded.status,
+			created_at = excluded.created_at`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save task: %w", err)
+	}
+
+	return nil
+}
+
+// GetTasks retrieves all tasks in insertion order.
+func (s *SQLiteStorage) GetTasks() ([]*storage.Task, error) {
+	rows, err := s.db.Query(`SELECT ` + taskColumns + ` FROM tasks ORDER BY seq`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	tasks := make([]*storage.Task, 0)
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// QueryTasks retrieves the page of tasks selected by the query.
+// Only the tasks on the page are loaded; the page position is found by counting the matching
+// tasks that sort before the bounding tasks.
+func (s *SQLiteStorage) QueryTasks(query storage.TaskQuery) (*storage.TaskPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	where, args := taskFilter(query)
+
+	sortColumn := "created_at"
+	if query.SortBy == storage.TaskSortTitle {
+		sortColumn = "title"
+	}
+
+	direction, before := "ASC", "<"
+	if query.Descending {
+		direction, before = "DESC", ">"
+	}
+
+	total, err := s.countTasks(where, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// countBefore counts the matching tasks sorting before the task with the given ID.
+	countBefore := func(id string, inclusive bool) (int, error) {
+		var key any
+		err := s.db.QueryRow(`SELECT `+sortColumn+` FROM tasks WHERE id = ?`, id).Scan(&key)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("task with ID %s not found", id)
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf("failed to get task: %w", err)
+		}
+
+		op := before
+		if inclusive {
+			op += "="
+		}
+
+		return s.countTasks(
+			andWhere(where, `(`+sortColumn+`, id) `+op+` (?, ?)`),
+			append(slices.Clone(args), key, id),
+		)
+	}
+
+	start, end := 0, total
+
+	if query.After != "" {
+		if start, err = countBefore(query.After, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if query.Before != "" {
+		if end, err = countBefore(query.Before, false); err != nil {
+			return nil, err
+		}
+	}
+
+	start, end = storage.PageBounds(start, end, total, query.First, query.Last)
+
+	rows, err := s.db.Query(
+		`SELECT `+taskColumns+` FROM tasks `+where+
+			` ORDER BY `+sortColumn+` `+direction+`, id `+direction+` LIMIT ? OFFSET ?`,
+		append(slices.Clone(args), end-start, start)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	tasks := make([]*storage.Task, 0, end-start)
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+
+	return &storage.TaskPage{
+		Tasks:           tasks,
+		TotalCount:      total,
+		HasPreviousPage: start > 0,
+		HasNextPage:     end < total,
+	}, nil
+}
+
+func (s *SQLiteStorage) countTasks(where string, args []any) (int, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM tasks `+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+
+	return count, nil
+}
+
+// taskFilter builds the WHERE clause matching the filters of the query.
+func taskFilter(query storage.TaskQuery) (string, []any) {
+	var (
+		where string
+		args  []any
+	)
+
+	if query.TitleContains != "" {
+		where = andWhere(where, `instr(lower(title), lower(?)) > 0`)
+		args = append(args, query.TitleContains)
+	}
+
+	if query.Tag != "" {
+		where = andWhere(where, `EXISTS (SELECT 1 FROM json_each(tasks.tags) WHERE json_each.value = ?)`)
+		args = append(args, query.Tag)
+	}
+
+	if len(query.Statuses) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(query.Statuses)), ", ")
+		where = andWhere(where, `(CASE status WHEN '' THEN 'todo' ELSE status END) IN (`+placeholders+`)`)
+
+		for _, status := range query.Statuses {
+			args = append(args, status)
+		}
+	}
+
+	if !query.CreatedAfter.IsZero() {
+		where = andWhere(where, `created_at >= ?`)
+		args = append(args, query.CreatedAfter.UnixNano())
+	}
+
+	if !query.CreatedBefore.IsZero() {
+		where = andWhere(where, `created_at < ?`)
+		args = append(args, query.CreatedBefore.UnixNano())
+	}
+
+	return where, args
+}
+
+func andWhere(where, condition string) string {
+	if where == "" {
+		return "WHERE " + condition
+	}
+
+	return where + " AND " + condition
+}
+
+// GetTaskByID retrieves a specific task by ID.
+func (s *SQLiteStorage) GetTaskByID(id string) (*storage.Task, error) {
+	row := s.db.QueryRow(`SELECT `+taskColumns+` FROM tasks WHERE id = ?`, id)
+
+	task, err := scanTask(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("task with ID %s not found", id)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return task, nil
+}
+
+// UpdateTask updates an existing task.
+func (s *SQLiteStorage) UpdateTask(task *storage.Task) error {
+	args, err := taskArgs(task)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.Exec(
+		`UPDATE tasks SET
+			title = ?, project = ?, tags = ?, estimated_pomodoros = ?, notes = ?, status = ?, created_at = ?
+		WHERE id = ?`,
+		append(args[1:], task.ID)...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	return requireAffected(res, task.ID)
+}
+
+// DeleteTask removes a task by ID.
+func (s *SQLiteStorage) DeleteTask(id string) error {
+	res, err := s.db.Exec(`DELETE FROM tasks WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	return requireAffected(res, id)
+}
+
+// AppendEvent stores an event with the next sequence number.
+// AUTOINCREMENT keeps sequence numbers from being reused.
+func (s *SQLiteStorage) AppendEvent(event *storage.Event) error {
+	res, err := s.db.Exec(
+		`INSERT INTO events (type, timestamp, payload) VALUES (?, ?, ?)`,
+		event.Type, toUnixNano(event.Timestamp), string(event.Payload),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append event: %w", err)
+	}
+
+	sequence, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get event sequence: %w", err)
+	}
+
+	event.Sequence = sequence
+
+	return nil
+}
+
+// GetEventsSince retrieves the events with a sequence number greater than sequence.
+func (s *SQLiteStorage) GetEventsSince(sequence int64) ([]*storage.Event, error) {
+	rows, err := s.db.Query(
+		`SELECT sequence, type, timestamp, payload FROM events WHERE sequence > ? ORDER BY sequence`,
+		sequence,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	events := make([]*storage.Event, 0)
+	for rows.Next() {
+		var (
+			event     storage.Event
+			timestamp int64
+			payload   string
+		)
+
+		if err := rows.Scan(&event.Sequence, &event.Type, &timestamp, &payload); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+
+		event.Timestamp = fromUnixNano(timestamp)
+		event.Payload = json.RawMessage(payload)
+
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	return events, nil
+}
+
+// AddDeadLetter appends a failed webhook delivery to the queue.
+func (s *SQLiteStorage) AddDeadLetter(letter *storage.DeadLetter) error {
+	_, err := s.db.Exec(
+		`INSERT INTO webhook_dead_letters (`+deadLetterColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		letter.ID, letter.Webhook, letter.DeliveryID, letter.URL, letter.EventType, string(letter.Payload),
+		letter.Attempts, letter.LastError, toUnixNano(letter.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeadLetters retrieves the queued webhook deliveries, oldest first.
+func (s *SQLiteStorage) GetDeadLetters() ([]*storage.DeadLetter, error) {
+	rows, err := s.db.Query(`SELECT ` + deadLetterColumns + ` FROM webhook_dead_letters ORDER BY seq`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letters: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	letters := make([]*storage.DeadLetter, 0)
+	for rows.Next() {
+		var (
+			letter    storage.DeadLetter
+			payload   string
+			createdAt int64
+		)
+
+		err := rows.Scan(
+			&letter.ID, &letter.Webhook, &letter.DeliveryID, &letter.URL, &letter.EventType, &payload,
+			&letter.Attempts, &letter.LastError, &createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dead letter: %w", err)
+		}
+
+		letter.Payload = json.RawMessage(payload)
+		letter.CreatedAt = fromUnixNano(createdAt)
+
+		letters = append(letters, &letter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get dead letters: %w", err)
+	}
+
+	return letters, nil
+}
+
+// DeleteDeadLetter removes a webhook delivery from the queue.
+func (s *SQLiteStorage) DeleteDeadLetter(id string) error {
+	res, err := s.db.Exec(`DELETE FROM webhook_dead_letters WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("dead letter with ID %s not found", id)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) queryPomodoros(query string, args ...any) ([]*storage.Pomodoro, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	pomodoros := make([]*storage.Pomodoro, 0)
+	for rows.Next() {
+		pomodoro, err := scanPomodoro(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pomodoro: %w", err)
+		}
+
+		pomodoros = append(pomodoros, pomodoro)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get pomodoro history: %w", err)
+	}
+
+	return pomodoros, nil
+}
+
+func (s *SQLiteStorage) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func insertPomodoro(db execer, table string, p *storage.Pomodoro) error {
+	_, err := db.Exec(
+		`INSERT INTO `+table+` (`+pomodoroColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		p.ID,
+		p.State,
+		toUnixNano(p.StartTime),
+		toUnixNano(p.EndTime),
+		int64(p.WorkDuration),
+		int64(p.BreakDuration),
+		int64(p.LongBreakDuration),
+		int64(p.RemainingTime),
+		int64(p.ElapsedTime),
+		p.Phase,
+		int64(p.PhaseDuration),
+		p.PhaseCount,
+		p.TaskID,
+		p.BreakFrequency,
+		toUnixNano(p.UpdatedAt),
+		p.AutoAdvance,
+	)
+
+	return err
+}
+
+func scanPomodoro(row scanner) (*storage.Pomodoro, error) {
+	var (
+		p                                              storage.Pomodoro
+		startTime, endTime, updatedAt                  int64
+		workDuration, breakDuration, longBreakDuration int64
+		remainingTime, elapsedTime, phaseDuration      int64
+	)
+
+	err := row.Scan(
+		&p.ID,
+		&p.State,
+		&startTime,
+		&endTime,
+		&workDuration,
+		&breakDuration,
+		&longBreakDuration,
+		&remainingTime,
+		&elapsedTime,
+		&p.Phase,
+		&phaseDuration,
+		&p.PhaseCount,
+		&p.TaskID,
+		&p.BreakFrequency,
+		&updatedAt,
+		&p.AutoAdvance,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.StartTime = fromUnixNano(startTime)
+	p.EndTime = fromUnixNano(endTime)
+	p.UpdatedAt = fromUnixNano(updatedAt)
+	p.WorkDuration = time.Duration(workDuration)
+	p.BreakDuration = time.Duration(breakDuration)
+	p.LongBreakDuration = time.Duration(longBreakDuration)
+	p.RemainingTime = time.Duration(remainingTime)
+	p.ElapsedTime = time.Duration(elapsedTime)
+	p.PhaseDuration = time.Duration(phaseDuration)
+
+	return &p, nil
+}
+
+// taskArgs returns the values of a task in the order of taskColumns.
+func taskArgs(task *storage.Task) ([]any, error) {
+	tags, err := json.Marshal(task.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task tags: %w", err)
+	}
+
+	return []any{
+		task.ID,
+		task.Title,
+		task.Project,
+		string(tags),
+		task.EstimatedPomodoros,
+		task.Notes,
+		task.Status,
+		toUnixNano(task.CreatedAt),
+	}, nil
+}
+
+func scanTask(row scanner) (*storage.Task, error) {
+	var (
+		t         storage.Task
+		tags      string
+		createdAt int64
+	)
+
+	if err := row.Scan(&t.ID, &t.Title, &t.Project, &tags, &t.EstimatedPomodoros, &t.Notes, &t.Status, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(tags), &t.Tags); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task tags: %w", err)
+	}
+
+	t.CreatedAt = fromUnixNano(createdAt)
+
+	return &t, nil
+}
+
+func requireAffected(res sql.Result, id string) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("task with ID %s not found", id)
+	}
+
+	return nil
+}
+
+// toUnixNano stores the zero time as 0 because its UnixNano is out of range.
+func toUnixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+func fromUnixNano(n int64) time.Time {
+	if n == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, n)
+}
@@ -0,0 +1,45 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/sqlite"
+	"github.com/hatappi/gomodoro/internal/storage/storagetest"
+)
+
+func TestSQLiteStorage(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		t.Helper()
+
+		s, err := sqlite.NewSQLiteStorage(config.StorageConfig{Dir: t.TempDir()})
+		if err != nil {
+			t.Fatalf("NewSQLiteStorage() returned error: %v", err)
+		}
+		t.Cleanup(func() {
+			_ = s.Close()
+		})
+
+		return s
+	})
+}
+
+func TestMigrationsAreIdempotent(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.StorageConfig{Dir: t.TempDir()}
+
+	for range 2 {
+		s, err := sqlite.NewSQLiteStorage(cfg)
+		if err != nil {
+			t.Fatalf("NewSQLiteStorage() returned error: %v", err)
+		}
+
+		if err := s.Close(); err != nil {
+			t.Fatalf("Close() returned error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,765 @@
+// Package storagetest provides behavioral tests shared by every storage.Storage implementation
+package storagetest
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+// Factory returns an empty storage for a single test.
+type Factory func(t *testing.T) storage.Storage
+
+// Run runs the behavioral test suite against the storage returned by newStorage.
+func Run(t *testing.T, newStorage Factory) {
+	t.Helper()
+
+	t.Run("Pomodoro", func(t *testing.T) {
+		t.Parallel()
+		testPomodoro(t, newStorage(t))
+	})
+	t.Run("PomodoroHistory", func(t *testing.T) {
+		t.Parallel()
+		testPomodoroHistory(t, newStorage(t))
+	})
+	t.Run("PomodoroHistoryQuery", func(t *testing.T) {
+		t.Parallel()
+		testPomodoroHistoryQuery(t, newStorage(t))
+	})
+	t.Run("Task", func(t *testing.T) {
+		t.Parallel()
+		testTask(t, newStorage(t))
+	})
+	t.Run("TaskQuery", func(t *testing.T) {
+		t.Parallel()
+		testTaskQuery(t, newStorage(t))
+	})
+	t.Run("Event", func(t *testing.T) {
+		t.Parallel()
+		testEvent(t, newStorage(t))
+	})
+	t.Run("DeadLetter", func(t *testing.T) {
+		t.Parallel()
+		testDeadLetter(t, newStorage(t))
+	})
+}
+
+func testPomodoro(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	latest, err := s.GetLatestPomodoro()
+	if err != nil {
+		t.Fatalf("GetLatestPomodoro() on empty storage returned error: %v", err)
+	}
+	if latest != nil {
+		t.Fatalf("GetLatestPomodoro() on empty storage = %+v, want nil", latest)
+	}
+
+	first := newPomodoro("first", time.Now().Add(-time.Hour))
+	if err := s.SavePomodoro(first); err != nil {
+		t.Fatalf("SavePomodoro() returned error: %v", err)
+	}
+
+	second := newPomodoro("second", time.Now())
+	if err := s.SavePomodoro(second); err != nil {
+		t.Fatalf("SavePomodoro() returned error: %v", err)
+	}
+
+	latest, err = s.GetLatestPomodoro()
+	if err != nil {
+		t.Fatalf("GetLatestPomodoro() returned error: %v", err)
+	}
+	assertPomodoro(t, latest, second)
+
+	active, err := s.GetActivePomodoro()
+	if err != nil {
+		t.Fatalf("GetActivePomodoro() returned error: %v", err)
+	}
+	assertPomodoro(t, active, second)
+
+	updatedAt := time.Unix(1700000000, 0)
+
+	updated, err := s.UpdatePomodoroState(second.ID, storage.PomodoroStatePaused, 100, 200, updatedAt)
+	if err != nil {
+		t.Fatalf("UpdatePomodoroState() returned error: %v", err)
+	}
+	if updated.State != storage.PomodoroStatePaused ||
+		updated.RemainingTime != 100*time.Second ||
+		updated.ElapsedTime != 200*time.Second {
+		t.Fatalf("UpdatePomodoroState() = %+v, want paused with 100s remaining and 200s elapsed", updated)
+	}
+	if !updated.UpdatedAt.Equal(updatedAt) {
+		t.Fatalf("UpdatePomodoroState() stamped UpdatedAt %v, want %v", updated.UpdatedAt, updatedAt)
+	}
+
+	if _, err := s.UpdatePomodoroState(first.ID, storage.PomodoroStateActive, 0, 0, updatedAt); err == nil {
+		t.Fatal("UpdatePomodoroState() for a replaced pomodoro returned no error")
+	}
+
+	if _, err := s.UpdatePomodoroState(second.ID, storage.PomodoroStateFinished, 0, 300, updatedAt); err != nil {
+		t.Fatalf("UpdatePomodoroState() returned error: %v", err)
+	}
+
+	active, err = s.GetActivePomodoro()
+	if err != nil {
+		t.Fatalf("GetActivePomodoro() returned error: %v", err)
+	}
+	if active != nil {
+		t.Fatalf("GetActivePomodoro() for a finished pomodoro = %+v, want nil", active)
+	}
+
+	if err := s.DeletePomodoro(first.ID); err != nil {
+		t.Fatalf("DeletePomodoro() with a mismatched ID returned error: %v", err)
+	}
+	if latest, _ := s.GetLatestPomodoro(); latest == nil {
+		t.Fatal("DeletePomodoro() with a mismatched ID deleted the current pomodoro")
+	}
+
+	if err := s.DeletePomodoro(second.ID); err != nil {
+		t.Fatalf("DeletePomodoro() returned error: %v", err)
+	}
+	if latest, _ := s.GetLatestPomodoro(); latest != nil {
+		t.Fatalf("GetLatestPomodoro() after delete = %+v, want nil", latest)
+	}
+}
+
+func testPomodoroHistory(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	history := []*storage.Pomodoro{
+		newPomodoro("a", base),
+		newPomodoro("b", base.Add(30*time.Minute)),
+		newPomodoro("c", base.Add(24*time.Hour)),
+	}
+	history[1].TaskID = "other-task"
+
+	for _, p := range history {
+		p.State = storage.PomodoroStateFinished
+		p.EndTime = p.StartTime.Add(p.ElapsedTime)
+
+		if err := s.AddPomodoroHistory(p); err != nil {
+			t.Fatalf("AddPomodoroHistory() returned error: %v", err)
+		}
+	}
+
+	all, err := s.GetPomodoroHistory(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("GetPomodoroHistory() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, all, "a", "b", "c")
+	assertPomodoro(t, all[0], history[0])
+
+	firstDay, err := s.GetPomodoroHistory(base, base.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("GetPomodoroHistory() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, firstDay, "a", "b")
+
+	since, err := s.GetPomodoroHistory(base.Add(time.Minute), time.Time{})
+	if err != nil {
+		t.Fatalf("GetPomodoroHistory() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, since, "b", "c")
+
+	byTask, err := s.GetPomodoroHistoryByTaskID("task")
+	if err != nil {
+		t.Fatalf("GetPomodoroHistoryByTaskID() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, byTask, "a", "c")
+
+	none, err := s.GetPomodoroHistoryByTaskID("missing")
+	if err != nil {
+		t.Fatalf("GetPomodoroHistoryByTaskID() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, none)
+
+	got, err := s.GetPomodoroHistoryByID("b")
+	if err != nil {
+		t.Fatalf("GetPomodoroHistoryByID() returned error: %v", err)
+	}
+	assertPomodoro(t, got, history[1])
+
+	if _, err := s.GetPomodoroHistoryByID("missing"); err == nil {
+		t.Error("GetPomodoroHistoryByID() with a missing ID returned no error")
+	}
+}
+
+func testPomodoroHistoryQuery(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Sessions are added out of order; "c" and "d" start at the same time and are ordered by ID.
+	seed := []struct {
+		id     string
+		offset time.Duration
+		phase  storage.PomodoroPhase
+		taskID string
+	}{
+		{id: "b", offset: 30 * time.Minute, phase: storage.PomodoroPhaseShortBreak, taskID: "task"},
+		{id: "a", offset: 0, phase: storage.PomodoroPhaseWork, taskID: "task"},
+		{id: "d", offset: time.Hour, phase: storage.PomodoroPhaseWork, taskID: "other-task"},
+		{id: "c", offset: time.Hour, phase: storage.PomodoroPhaseWork, taskID: "task"},
+		{id: "e", offset: 2 * time.Hour, phase: storage.PomodoroPhaseLongBreak, taskID: "task"},
+	}
+	for _, tt := range seed {
+		p := newPomodoro(tt.id, base.Add(tt.offset))
+		p.State = storage.PomodoroStateFinished
+		p.Phase = tt.phase
+		p.TaskID = tt.taskID
+
+		if err := s.AddPomodoroHistory(p); err != nil {
+			t.Fatalf("AddPomodoroHistory() returned error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		query        storage.PomodoroQuery
+		want         []string
+		wantTotal    int
+		wantPrevious bool
+		wantNext     bool
+	}{
+		{name: "all", want: []string{"a", "b", "c", "d", "e"}, wantTotal: 5},
+		{name: "task", query: storage.PomodoroQuery{TaskID: "other-task"}, want: []string{"d"}, wantTotal: 1},
+		{
+			name: "phases",
+			query: storage.PomodoroQuery{
+				Phases: []storage.PomodoroPhase{storage.PomodoroPhaseShortBreak, storage.PomodoroPhaseLongBreak},
+			},
+			want:      []string{"b", "e"},
+			wantTotal: 2,
+		},
+		{
+			name:      "start range",
+			query:     storage.PomodoroQuery{StartedAfter: base.Add(time.Minute), StartedBefore: base.Add(2 * time.Hour)},
+			want:      []string{"b", "c", "d"},
+			wantTotal: 3,
+		},
+		{
+			name:      "first",
+			query:     storage.PomodoroQuery{First: 3},
+			want:      []string{"a", "b", "c"},
+			wantTotal: 5,
+			wantNext:  true,
+		},
+		{
+			name:         "first after a tie",
+			query:        storage.PomodoroQuery{First: 1, After: "c"},
+			want:         []string{"d"},
+			wantTotal:    5,
+			wantPrevious: true,
+			wantNext:     true,
+		},
+		{
+			name:         "after a session outside the filter",
+			query:        storage.PomodoroQuery{TaskID: "task", After: "d"},
+			want:         []string{"e"},
+			wantTotal:    4,
+			wantPrevious: true,
+		},
+		{
+			name:         "past the end",
+			query:        storage.PomodoroQuery{After: "e"},
+			want:         []string{},
+			wantTotal:    5,
+			wantPrevious: true,
+		},
+	}
+
+	for _, tt := range tests {
+		page, err := s.QueryPomodoroHistory(tt.query)
+		if err != nil {
+			t.Fatalf("%s: QueryPomodoroHistory() returned error: %v", tt.name, err)
+		}
+
+		ids := make([]string, len(page.Pomodoros))
+		for i, p := range page.Pomodoros {
+			ids[i] = p.ID
+		}
+
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("%s: QueryPomodoroHistory() IDs = %v, want %v", tt.name, ids, tt.want)
+		}
+
+		if page.TotalCount != tt.wantTotal || page.HasPreviousPage != tt.wantPrevious || page.HasNextPage != tt.wantNext {
+			t.Errorf("%s: QueryPomodoroHistory() total/previous/next = %d/%t/%t, want %d/%t/%t",
+				tt.name, page.TotalCount, page.HasPreviousPage, page.HasNextPage, tt.wantTotal, tt.wantPrevious, tt.wantNext)
+		}
+	}
+
+	if _, err := s.QueryPomodoroHistory(storage.PomodoroQuery{After: "missing"}); err == nil {
+		t.Error("QueryPomodoroHistory() after a missing session returned no error")
+	}
+
+	// The other history lookups order the sessions like the query, including ties.
+	all, err := s.GetPomodoroHistory(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("GetPomodoroHistory() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, all, "a", "b", "c", "d", "e")
+
+	byTask, err := s.GetPomodoroHistoryByTaskID("task")
+	if err != nil {
+		t.Fatalf("GetPomodoroHistoryByTaskID() returned error: %v", err)
+	}
+	assertPomodoroIDs(t, byTask, "a", "b", "c", "e")
+}
+
+func testTask(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	tasks, err := s.GetTasks()
+	if err != nil {
+		t.Fatalf("GetTasks() on empty storage returned error: %v", err)
+	}
+	if len(tasks) != 0 {
+		t.Fatalf("GetTasks() on empty storage returned %d tasks, want 0", len(tasks))
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	for _, id := range []string{"1", "2", "3"} {
+		if err := s.SaveTask(&storage.Task{ID: id, Title: "task " + id, CreatedAt: createdAt}); err != nil {
+			t.Fatalf("SaveTask() returned error: %v", err)
+		}
+	}
+
+	if err := s.SaveTask(&storage.Task{ID: "1", Title: "renamed", CreatedAt: createdAt}); err != nil {
+		t.Fatalf("SaveTask() for an existing task returned error: %v", err)
+	}
+
+	tasks, err = s.GetTasks()
+	if err != nil {
+		t.Fatalf("GetTasks() returned error: %v", err)
+	}
+	assertTaskIDs(t, tasks, "1", "2", "3")
+
+	task, err := s.GetTaskByID("1")
+	if err != nil {
+		t.Fatalf("GetTaskByID() returned error: %v", err)
+	}
+	if task.Title != "renamed" || !task.CreatedAt.Equal(createdAt) {
+		t.Fatalf("GetTaskByID() = %+v, want the renamed task", task)
+	}
+
+	task.Title = "updated"
+	task.Project = "gomodoro"
+	task.Tags = []string{"backend", "storage"}
+	task.EstimatedPomodoros = 3
+	task.Status = storage.TaskStatusDone
+	task.Notes = "# Notes\n\n- keep the *markdown*"
+	if err := s.UpdateTask(task); err != nil {
+		t.Fatalf("UpdateTask() returned error: %v", err)
+	}
+	updated, err := s.GetTaskByID("1")
+	if err != nil {
+		t.Fatalf("GetTaskByID() after update returned error: %v", err)
+	}
+	if !reflect.DeepEqual(updated, task) {
+		t.Fatalf("GetTaskByID() after update = %+v, want %+v", updated, task)
+	}
+
+	if err := s.UpdateTask(&storage.Task{ID: "missing"}); err == nil {
+		t.Fatal("UpdateTask() for a missing task returned no error")
+	}
+
+	if err := s.DeleteTask("2"); err != nil {
+		t.Fatalf("DeleteTask() returned error: %v", err)
+	}
+	if err := s.DeleteTask("2"); err == nil {
+		t.Fatal("DeleteTask() for a missing task returned no error")
+	}
+	if _, err := s.GetTaskByID("2"); err == nil {
+		t.Fatal("GetTaskByID() for a deleted task returned no error")
+	}
+
+	tasks, err = s.GetTasks()
+	if err != nil {
+		t.Fatalf("GetTasks() returned error: %v", err)
+	}
+	assertTaskIDs(t, tasks, "1", "3")
+}
+
+func testTaskQuery(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	seed := []*storage.Task{
+		{ID: "a", Title: "Write docs", Tags: []string{"docs"}, Status: storage.TaskStatusTodo},
+		{ID: "b", Title: "write tests", Tags: []string{"test", "core"}, Status: storage.TaskStatusInProgress},
+		{ID: "c", Title: "Fix bug", Tags: []string{"core"}, Status: storage.TaskStatusDone},
+		// Tasks saved before statuses existed have no status.
+		{ID: "d", Title: "Refactor"},
+		{ID: "e", Title: "Archive old", Tags: []string{"docs"}, Status: storage.TaskStatusArchived},
+	}
+	for i, task := range seed {
+		task.CreatedAt = createdAt.Add(time.Duration(i) * time.Hour)
+		if err := s.SaveTask(task); err != nil {
+			t.Fatalf("SaveTask() returned error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		query        storage.TaskQuery
+		want         []string
+		wantTotal    int
+		wantPrevious bool
+		wantNext     bool
+	}{
+		{name: "all", want: []string{"a", "b", "c", "d", "e"}, wantTotal: 5},
+		{
+			name:      "title ignores case",
+			query:     storage.TaskQuery{TitleContains: "WRITE"},
+			want:      []string{"a", "b"},
+			wantTotal: 2,
+		},
+		{name: "tag", query: storage.TaskQuery{Tag: "core"}, want: []string{"b", "c"}, wantTotal: 2},
+		{
+			name:      "status",
+			query:     storage.TaskQuery{Statuses: []storage.TaskStatus{storage.TaskStatusTodo}},
+			want:      []string{"a", "d"},
+			wantTotal: 2,
+		},
+		{
+			name:      "created range",
+			query:     storage.TaskQuery{CreatedAfter: createdAt.Add(time.Hour), CreatedBefore: createdAt.Add(3 * time.Hour)},
+			want:      []string{"b", "c"},
+			wantTotal: 2,
+		},
+		{
+			name:      "sort by title",
+			query:     storage.TaskQuery{SortBy: storage.TaskSortTitle},
+			want:      []string{"e", "c", "d", "a", "b"},
+			wantTotal: 5,
+		},
+		{
+			name:      "sort by title descending",
+			query:     storage.TaskQuery{SortBy: storage.TaskSortTitle, Descending: true},
+			want:      []string{"b", "a", "d", "c", "e"},
+			wantTotal: 5,
+		},
+		{
+			name:      "first",
+			query:     storage.TaskQuery{First: 2},
+			want:      []string{"a", "b"},
+			wantTotal: 5,
+			wantNext:  true,
+		},
+		{
+			name:         "first after",
+			query:        storage.TaskQuery{First: 2, After: "b"},
+			want:         []string{"c", "d"},
+			wantTotal:    5,
+			wantPrevious: true,
+			wantNext:     true,
+		},
+		{
+			name:         "last",
+			query:        storage.TaskQuery{Last: 2},
+			want:         []string{"d", "e"},
+			wantTotal:    5,
+			wantPrevious: true,
+		},
+		{
+			name:         "last before",
+			query:        storage.TaskQuery{Last: 2, Before: "d"},
+			want:         []string{"b", "c"},
+			wantTotal:    5,
+			wantPrevious: true,
+			wantNext:     true,
+		},
+		{
+			name:         "after a task outside the filter",
+			query:        storage.TaskQuery{Tag: "docs", After: "b"},
+			want:         []string{"e"},
+			wantTotal:    2,
+			wantPrevious: true,
+		},
+		{
+			name:      "first descending",
+			query:     storage.TaskQuery{First: 2, Descending: true},
+			want:      []string{"e", "d"},
+			wantTotal: 5,
+			wantNext:  true,
+		},
+		{
+			name:         "past the end",
+			query:        storage.TaskQuery{After: "e"},
+			want:         []string{},
+			wantTotal:    5,
+			wantPrevious: true,
+		},
+	}
+
+	for _, tt := range tests {
+		page, err := s.QueryTasks(tt.query)
+		if err != nil {
+			t.Fatalf("%s: QueryTasks() returned error: %v", tt.name, err)
+		}
+
+		ids := make([]string, len(page.Tasks))
+		for i, task := range page.Tasks {
+			ids[i] = task.ID
+		}
+
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("%s: QueryTasks() IDs = %v, want %v", tt.name, ids, tt.want)
+		}
+
+		if page.TotalCount != tt.wantTotal || page.HasPreviousPage != tt.wantPrevious || page.HasNextPage != tt.wantNext {
+			t.Errorf("%s: QueryTasks() total/previous/next = %d/%t/%t, want %d/%t/%t",
+				tt.name, page.TotalCount, page.HasPreviousPage, page.HasNextPage, tt.wantTotal, tt.wantPrevious, tt.wantNext)
+		}
+	}
+
+	if _, err := s.QueryTasks(storage.TaskQuery{After: "missing"}); err == nil {
+		t.Error("QueryTasks() after a missing task returned no error")
+	}
+
+	if _, err := s.QueryTasks(storage.TaskQuery{SortBy: "priority"}); err == nil {
+		t.Error("QueryTasks() with an unknown sort field returned no error")
+	}
+}
+
+func testEvent(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	events, err := s.GetEventsSince(0)
+	if err != nil {
+		t.Fatalf("GetEventsSince() on empty storage returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("GetEventsSince() on empty storage = %d events, want 0", len(events))
+	}
+
+	timestamp := time.Unix(1700000000, 0)
+	types := []string{"task.created", "pomodoro.started", "pomodoro.paused"}
+
+	for i, eventType := range types {
+		e := &storage.Event{
+			Type:      eventType,
+			Timestamp: timestamp.Add(time.Duration(i) * time.Second),
+			Payload:   json.RawMessage(`{"type":"` + eventType + `"}`),
+		}
+
+		if err := s.AppendEvent(e); err != nil {
+			t.Fatalf("AppendEvent() returned error: %v", err)
+		}
+
+		if want := int64(i + 1); e.Sequence != want {
+			t.Errorf("AppendEvent() set sequence %d, want %d", e.Sequence, want)
+		}
+	}
+
+	events, err = s.GetEventsSince(1)
+	if err != nil {
+		t.Fatalf("GetEventsSince() returned error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("GetEventsSince(1) = %d events, want 2", len(events))
+	}
+
+	for i, e := range events {
+		if e.Sequence != int64(i+2) || e.Type != types[i+1] {
+			t.Errorf("GetEventsSince(1)[%d] = sequence %d type %q, want sequence %d type %q",
+				i, e.Sequence, e.Type, i+2, types[i+1])
+		}
+
+		if want := timestamp.Add(time.Duration(i+1) * time.Second); !e.Timestamp.Equal(want) {
+			t.Errorf("GetEventsSince(1)[%d].Timestamp = %v, want %v", i, e.Timestamp, want)
+		}
+
+		if want := `{"type":"` + types[i+1] + `"}`; string(e.Payload) != want {
+			t.Errorf("GetEventsSince(1)[%d].Payload = %s, want %s", i, e.Payload, want)
+		}
+	}
+
+	events, err = s.GetEventsSince(3)
+	if err != nil {
+		t.Fatalf("GetEventsSince() returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("GetEventsSince(3) = %d events, want 0", len(events))
+	}
+
+	// A task event carries the notes of the task, which have no length limit.
+	large := &storage.Event{Type: "task.updated", Timestamp: timestamp, Payload: largePayload()}
+	if err := s.AppendEvent(large); err != nil {
+		t.Fatalf("AppendEvent() of a large event returned error: %v", err)
+	}
+
+	next := &storage.Event{Type: "task.deleted", Timestamp: timestamp, Payload: json.RawMessage(`{}`)}
+	if err := s.AppendEvent(next); err != nil {
+		t.Fatalf("AppendEvent() after a large event returned error: %v", err)
+	}
+	if next.Sequence != 5 {
+		t.Errorf("AppendEvent() after a large event set sequence %d, want 5", next.Sequence)
+	}
+
+	events, err = s.GetEventsSince(3)
+	if err != nil {
+		t.Fatalf("GetEventsSince() returned error: %v", err)
+	}
+	if len(events) != 2 || string(events[0].Payload) != string(large.Payload) {
+		t.Errorf("GetEventsSince(3) = %d events, want the large event and the next one", len(events))
+	}
+}
+
+func testDeadLetter(t *testing.T, s storage.Storage) {
+	t.Helper()
+
+	letters, err := s.GetDeadLetters()
+	if err != nil {
+		t.Fatalf("GetDeadLetters() on empty storage returned error: %v", err)
+	}
+	if len(letters) != 0 {
+		t.Fatalf("GetDeadLetters() on empty storage = %d letters, want 0", len(letters))
+	}
+
+	first := &storage.DeadLetter{
+		ID:         "first",
+		Webhook:    "chat",
+		DeliveryID: "delivery",
+		URL:        "https://example.com/hook",
+		EventType:  "pomodoro.completed",
+		Payload:    json.RawMessage(`{"type":"pomodoro.completed"}`),
+		Attempts:   4,
+		LastError:  "unexpected status 503",
+		CreatedAt:  time.Unix(1700000000, 0),
+	}
+	second := &storage.DeadLetter{ID: "second", Payload: largePayload(), CreatedAt: time.Unix(1700000100, 0)}
+
+	for _, letter := range []*storage.DeadLetter{first, second} {
+		if err := s.AddDeadLetter(letter); err != nil {
+			t.Fatalf("AddDeadLetter() returned error: %v", err)
+		}
+	}
+
+	letters, err = s.GetDeadLetters()
+	if err != nil {
+		t.Fatalf("GetDeadLetters() returned error: %v", err)
+	}
+	if len(letters) != 2 {
+		t.Fatalf("GetDeadLetters() = %d letters, want 2", len(letters))
+	}
+
+	got := letters[0]
+	if got.ID != first.ID || got.Webhook != first.Webhook || got.DeliveryID != first.DeliveryID ||
+		got.URL != first.URL || got.EventType != first.EventType ||
+		string(got.Payload) != string(first.Payload) || got.Attempts != first.Attempts ||
+		got.LastError != first.LastError || !got.CreatedAt.Equal(first.CreatedAt) {
+		t.Errorf("GetDeadLetters()[0] = %+v, want %+v", got, first)
+	}
+
+	if err := s.DeleteDeadLetter("first"); err != nil {
+		t.Fatalf("DeleteDeadLetter() returned error: %v", err)
+	}
+
+	if err := s.DeleteDeadLetter("first"); err == nil {
+		t.Error("DeleteDeadLetter() of a deleted letter returned no error")
+	}
+
+	letters, err = s.GetDeadLetters()
+	if err != nil {
+		t.Fatalf("GetDeadLetters() returned error: %v", err)
+	}
+	if len(letters) != 1 || letters[0].ID != "second" || string(letters[0].Payload) != string(second.Payload) {
+		t.Errorf("GetDeadLetters() after delete = %d letters, want second only", len(letters))
+	}
+}
+
+// largePayload returns a JSON payload that is longer than the default line limit of bufio.Scanner.
+func largePayload() json.RawMessage {
+	return json.RawMessage(`{"notes":"` + strings.Repeat("a", 100*1024) + `"}`)
+}
+
+func newPomodoro(id string, startTime time.Time) *storage.Pomodoro {
+	return &storage.Pomodoro{
+		ID:                id,
+		State:             storage.PomodoroStateActive,
+		StartTime:         startTime,
+		WorkDuration:      25 * time.Minute,
+		BreakDuration:     5 * time.Minute,
+		LongBreakDuration: 15 * time.Minute,
+		RemainingTime:     20 * time.Minute,
+		ElapsedTime:       5 * time.Minute,
+		Phase:             storage.PomodoroPhaseWork,
+		PhaseDuration:     25 * time.Minute,
+		PhaseCount:        1,
+		BreakFrequency:    4,
+		UpdatedAt:         startTime,
+		TaskID:            "task",
+		AutoAdvance:       true,
+	}
+}
+
+func assertPomodoro(t *testing.T, got, want *storage.Pomodoro) {
+	t.Helper()
+
+	if got == nil {
+		t.Fatalf("pomodoro = nil, want %+v", want)
+	}
+
+	if got.ID != want.ID ||
+		got.State != want.State ||
+		!got.StartTime.Equal(want.StartTime) ||
+		!got.EndTime.Equal(want.EndTime) ||
+		got.WorkDuration != want.WorkDuration ||
+		got.BreakDuration != want.BreakDuration ||
+		got.LongBreakDuration != want.LongBreakDuration ||
+		got.RemainingTime != want.RemainingTime ||
+		got.ElapsedTime != want.ElapsedTime ||
+		got.Phase != want.Phase ||
+		got.PhaseDuration != want.PhaseDuration ||
+		got.PhaseCount != want.PhaseCount ||
+		got.BreakFrequency != want.BreakFrequency ||
+		!got.UpdatedAt.Equal(want.UpdatedAt) ||
+		got.TaskID != want.TaskID ||
+		got.AutoAdvance != want.AutoAdvance {
+		t.Fatalf("pomodoro = %+v, want %+v", got, want)
+	}
+}
+
+func assertPomodoroIDs(t *testing.T, got []*storage.Pomodoro, want ...string) {
+	t.Helper()
+
+	ids := make([]string, len(got))
+	for i, p := range got {
+		ids[i] = p.ID
+	}
+
+	assertIDs(t, ids, want)
+}
+
+func assertTaskIDs(t *testing.T, got []*storage.Task, want ...string) {
+	t.Helper()
+
+	ids := make([]string, len(got))
+	for i, task := range got {
+		ids[i] = task.ID
+	}
+
+	assertIDs(t, ids, want)
+}
+
+func assertIDs(t *testing.T, got, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("IDs = %v, want %v", got, want)
+	}
+
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("IDs = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TaskSortField is the field tasks are ordered by.
+type TaskSortField string
+
+const (
+	// TaskSortCreatedAt orders tasks by creation time.
+	TaskSortCreatedAt TaskSortField = "created_at"
+	// TaskSortTitle orders tasks by title.
+	TaskSortTitle TaskSortField = "title"
+)
+
+// TaskQuery selects a page of tasks.
+// Tasks are filtered first, then ordered, and the page is cut from the ordered list.
+type TaskQuery struct {
+	// TitleContains matches tasks whose title contains the string, ignoring ASCII case.
+	TitleContains string
+	// Tag matches tasks carrying the tag.
+	Tag string
+	// Statuses matches tasks in any of the statuses. Empty matches every status.
+	Statuses []TaskStatus
+	// CreatedAfter and CreatedBefore match tasks created within [CreatedAfter, CreatedBefore).
+	// A zero time leaves that side of the range open.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// SortBy is the field to order by. Ties are broken by ID. Empty orders by creation time.
+	SortBy     TaskSortField
+	Descending bool
+
+	// After and Before are IDs of tasks bounding the page, exclusively.
+	// The bounding task itself does not have to match the filters.
+	After  string
+	Before string
+	// First keeps only the first tasks within the bounds, and Last keeps only the last ones
+	// after First is applied. Zero means no limit.
+	First int
+	Last  int
+}
+
+// TaskPage is a page of tasks selected by a TaskQuery.
+type TaskPage struct {
+	Tasks []*Task
+	// TotalCount is the number of tasks matching the filters, regardless of the page bounds.
+	TotalCount int
+	// HasPreviousPage and HasNextPage report whether matching tasks exist before and after the page.
+	HasPreviousPage bool
+	HasNextPage     bool
+}
+
+// Validate reports whether the query can be run.
+func (q TaskQuery) Validate() error {
+	switch q.SortBy {
+	case "", TaskSortCreatedAt, TaskSortTitle:
+	default:
+		return fmt.Errorf("unknown task sort field: %s", q.SortBy)
+	}
+
+	if q.First < 0 || q.Last < 0 {
+		return fmt.Errorf("page size cannot be negative")
+	}
+
+	return nil
+}
+
+// Matches reports whether the task passes the filters of the query.
+func (q TaskQuery) Matches(task *Task) bool {
+	if q.TitleContains != "" && !strings.Contains(asciiLower(task.Title), asciiLower(q.TitleContains)) {
+		return false
+	}
+
+	if q.Tag != "" && !slices.Contains(task.Tags, q.Tag) {
+		return false
+	}
+
+	if len(q.Statuses) > 0 && !slices.Contains(q.Statuses, NormalizeTaskStatus(task.Status)) {
+		return false
+	}
+
+	if !q.CreatedAfter.IsZero() && task.CreatedAt.Before(q.CreatedAfter) {
+		return false
+	}
+
+	if !q.CreatedBefore.IsZero() && !task.CreatedAt.Before(q.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// Compare orders two tasks by the sort order of the query.
+func (q TaskQuery) Compare(a, b *Task) int {
+	var c int
+	if q.SortBy == TaskSortTitle {
+		c = strings.Compare(a.Title, b.Title)
+	} else {
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+
+	c = cmp.Or(c, strings.Compare(a.ID, b.ID))
+
+	if q.Descending {
+		return -c
+	}
+
+	return c
+}
+
+// NormalizeTaskStatus treats tasks saved before statuses existed as todo.
+func NormalizeTaskStatus(status TaskStatus) TaskStatus {
+	if status == "" {
+		return TaskStatusTodo
+	}
+
+	return status
+}
+
+// PageTasks runs the query over all tasks in memory.
+// It serves the storages that have to load every task anyway.
+func PageTasks(tasks []*Task, q TaskQuery) (*TaskPage, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	matched := make([]*Task, 0, len(tasks))
+	for _, task := range tasks {
+		if q.Matches(task) {
+			matched = append(matched, task)
+		}
+	}
+
+	slices.SortFunc(matched, q.Compare)
+
+	start, end := 0, len(matched)
+
+	if q.After != "" {
+		after, err := findTask(tasks, q.After)
+		if err != nil {
+			return nil, err
+		}
+
+		start, _ = slices.BinarySearchFunc(matched, after, q.Compare)
+		if start < len(matched) && matched[start].ID == after.ID {
+			start++
+		}
+	}
+
+	if q.Before != "" {
+		before, err := findTask(tasks, q.Before)
+		if err != nil {
+			return nil, err
+		}
+
+		end, _ = slices.BinarySearchFunc(matched, before, q.Compare)
+	}
+
+	start, end = PageBounds(start, end, len(matched), q.First, q.Last)
+
+	return &TaskPage{
+		Tasks:           matched[start:end],
+		TotalCount:      len(matched),
+		HasPreviousPage: start > 0,
+		HasNextPage:     end < len(matched),
+	}, nil
+}
+
+// PageBounds narrows [start, end) of the ordered matching tasks down to the page,
+// given the positions of the After and Before bounds and the First and Last limits.
+func PageBounds(start, end, total, first, last int) (int, int) {
+	end = max(min(end, total), start)
+
+	if first > 0 {
+		end = min(end, start+first)
+	}
+
+	if last > 0 {
+		start = max(start, end-last)
+	}
+
+	return start, end
+}
+
+func findTask(tasks []*Task, id string) (*Task, error) {
+	for _, task := range tasks {
+		if task.ID == id {
+			return task, nil
+		}
+	}
+
+	return nil, fmt.Errorf("task with ID %s not found", id)
+}
+
+// asciiLower lowercases ASCII letters only, matching SQLite's lower().
+func asciiLower(s string) string {
+	return strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + ('a' - 'A')
+		}
+
+		return r
+	}, s)
+}
@@ -0,0 +1,111 @@
+// Package taskedit turns tasks into lines of text to edit and the edited lines back into changes.
+package taskedit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hatappi/gomodoro/internal/core"
+)
+
+// Header explains the format at the top of the text to edit.
+const Header = `# One task per line as "<ID> <title>".
+# Edit a title to rename the task, delete a line to delete the task,
+# and add a line without an ID to create a task. Lines starting with # are ignored.
+`
+
+// ErrNoTasks is returned for edited text without a task line, which is taken as aborting the edit
+// rather than deleting every task.
+var ErrNoTasks = errors.New("the edited text has no tasks, nothing was changed")
+
+// Rename is a change of the title of an existing task.
+type Rename struct {
+	ID    string
+	Title string
+}
+
+// Diff holds the changes made to the text of a list of tasks.
+type Diff struct {
+	// Create holds the titles of the lines added without an ID.
+	Create []string
+	Rename []Rename
+	// Delete holds the IDs of the tasks whose lines were removed.
+	Delete []string
+}
+
+// IsEmpty reports whether nothing was changed.
+func (d *Diff) IsEmpty() bool {
+	return len(d.Create) == 0 && len(d.Rename) == 0 && len(d.Delete) == 0
+}
+
+// Format returns the text to edit for tasks, one task per line after the Header.
+func Format(tasks []*core.Task) string {
+	var b strings.Builder
+
+	b.WriteString(Header)
+
+	for _, task := range tasks {
+		fmt.Fprintf(&b, "%s %s\n", task.ID, task.Title)
+	}
+
+	return b.String()
+}
+
+// Parse compares the edited lines with the tasks they were formatted from.
+// A line is an existing task if it starts with the ID of one of tasks, and a new task otherwise.
+// Like an emptied todo list of git rebase, text without task lines aborts with ErrNoTasks.
+func Parse(lines []string, tasks []*core.Task) (*Diff, error) {
+	titles := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		titles[task.ID] = task.Title
+	}
+
+	diff := &Diff{}
+	kept := make(map[string]bool, len(tasks))
+	empty := true
+
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		empty = false
+
+		id, title, _ := strings.Cut(line, " ")
+		title = strings.TrimSpace(title)
+
+		oldTitle, ok := titles[id]
+		if !ok {
+			diff.Create = append(diff.Create, line)
+			continue
+		}
+
+		if kept[id] {
+			return nil, fmt.Errorf("line %d: task %s appears more than once", i+1, id)
+		}
+
+		kept[id] = true
+
+		if title == "" {
+			return nil, fmt.Errorf("line %d: task %s has no title", i+1, id)
+		}
+
+		if title != oldTitle {
+			diff.Rename = append(diff.Rename, Rename{ID: id, Title: title})
+		}
+	}
+
+	if empty && len(tasks) > 0 {
+		return nil, ErrNoTasks
+	}
+
+	for _, task := range tasks {
+		if !kept[task.ID] {
+			diff.Delete = append(diff.Delete, task.ID)
+		}
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,94 @@
+package taskedit_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/taskedit"
+)
+
+var tasks = []*core.Task{
+	{ID: "a", Title: "write docs"},
+	{ID: "b", Title: "review"},
+	{ID: "c", Title: "deploy"},
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	got := taskedit.Format(tasks)
+	want := taskedit.Header + "a write docs\nb review\nc deploy\n"
+
+	if got != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want *taskedit.Diff
+	}{
+		{
+			name: "unchanged",
+			text: taskedit.Format(tasks),
+			want: &taskedit.Diff{},
+		},
+		{
+			name: "create, rename and delete",
+			text: "# comment\na write the docs\n\nc deploy\nplan the release\n",
+			want: &taskedit.Diff{
+				Create: []string{"plan the release"},
+				Rename: []taskedit.Rename{{ID: "a", Title: "write the docs"}},
+				Delete: []string{"b"},
+			},
+		},
+		{
+			name: "reordered",
+			text: "c deploy\nb review\na write docs\n",
+			want: &taskedit.Diff{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := taskedit.Parse(strings.Split(tt.text, "\n"), tasks)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"a write docs\na again", "a"} {
+		if _, err := taskedit.Parse(strings.Split(text, "\n"), tasks); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", text)
+		}
+	}
+
+	for _, text := range []string{"", taskedit.Header + "\n\n"} {
+		if _, err := taskedit.Parse(strings.Split(text, "\n"), tasks); !errors.Is(err, taskedit.ErrNoTasks) {
+			t.Errorf("Parse(%q) error = %v, want ErrNoTasks", text, err)
+		}
+	}
+
+	// Without tasks to begin with, an empty text changes nothing.
+	if diff, err := taskedit.Parse([]string{""}, nil); err != nil || !diff.IsEmpty() {
+		t.Errorf("Parse() of empty text without tasks = %+v, %v, want no changes", diff, err)
+	}
+}
@@ -0,0 +1,310 @@
+// Package taskimport parses task lists written in other formats into tasks to create.
+package taskimport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+)
+
+// Format is a task list format.
+type Format string
+
+const (
+	// FormatMarkdown is a Markdown checklist of "- [ ] title" items.
+	// The nearest heading above an item becomes its project and #words become tags.
+	FormatMarkdown Format = "markdown"
+	// FormatTodoTxt is the todo.txt format. +project becomes the project, @context and the priority become tags,
+	// and key:value pairs are kept in the notes.
+	FormatTodoTxt Format = "todotxt"
+	// FormatCSV is CSV with a header row naming the columns title, project, tags, estimate, notes and status.
+	// Only the title column is required.
+	FormatCSV Format = "csv"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatMarkdown, FormatTodoTxt, FormatCSV}
+
+// ParseFormat returns the format with the given name.
+func ParseFormat(name string) (Format, error) {
+	f := Format(name)
+	if !slices.Contains(Formats, f) {
+		return "", fmt.Errorf("unknown import format: %s", name)
+	}
+
+	return f, nil
+}
+
+// Task is a task read from a task list.
+type Task struct {
+	Title   string
+	Details core.TaskDetails
+	// Status is the status to move the task to after creating it, or empty to leave it todo.
+	Status event.TaskStatus
+}
+
+// Parse reads the tasks of a task list in the given format.
+func Parse(r io.Reader, format Format) ([]Task, error) {
+	switch format {
+	case FormatMarkdown:
+		return parseMarkdown(r)
+	case FormatTodoTxt:
+		return parseTodoTxt(r)
+	case FormatCSV:
+		return parseCSV(r)
+	default:
+		return nil, fmt.Errorf("unknown import format: %s", format)
+	}
+}
+
+// Dedupe splits tasks into the ones to create and the ones whose title, ignoring case,
+// is already taken by an existing task or an earlier task of the list.
+func Dedupe(tasks []Task, existing []*core.Task) ([]Task, []Task) {
+	seen := make(map[string]bool, len(existing)+len(tasks))
+	for _, task := range existing {
+		seen[strings.ToLower(task.Title)] = true
+	}
+
+	var fresh, duplicates []Task
+
+	for _, task := range tasks {
+		key := strings.ToLower(task.Title)
+		if seen[key] {
+			duplicates = append(duplicates, task)
+			continue
+		}
+
+		seen[key] = true
+		fresh = append(fresh, task)
+	}
+
+	return fresh, duplicates
+}
+
+var (
+	markdownItem    = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.+)$`)
+	markdownHeading = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+)
+
+func parseMarkdown(r io.Reader) ([]Task, error) {
+	var (
+		tasks   []Task
+		project string
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if m := markdownHeading.FindStringSubmatch(line); m != nil {
+			project = m[1]
+			continue
+		}
+
+		m := markdownItem.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		var (
+			words []string
+			tags  []string
+		)
+
+		for _, word := range strings.Fields(m[2]) {
+			if tag, ok := strings.CutPrefix(word, "#"); ok && tag != "" {
+				tags = append(tags, tag)
+				continue
+			}
+
+			words = append(words, word)
+		}
+
+		if len(words) == 0 {
+			continue
+		}
+
+		task := Task{
+			Title:   strings.Join(words, " "),
+			Details: core.TaskDetails{Project: project, Tags: tags},
+		}
+
+		if m[1] != " " {
+			task.Status = event.TaskStatusDone
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read markdown: %w", err)
+	}
+
+	return tasks, nil
+}
+
+var (
+	todoTxtPriority = regexp.MustCompile(`^\(([A-Z])\)$`)
+	todoTxtDate     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	todoTxtKeyValue = regexp.MustCompile(`^[^:\s]+:[^:\s]+$`)
+)
+
+func parseTodoTxt(r io.Reader) ([]Task, error) {
+	var tasks []Task
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var task Task
+
+		if fields[0] == "x" {
+			task.Status = event.TaskStatusDone
+			fields = fields[1:]
+		}
+
+		if len(fields) > 0 && todoTxtPriority.MatchString(fields[0]) {
+			task.Details.Tags = append(task.Details.Tags, "priority:"+fields[0][1:2])
+			fields = fields[1:]
+		}
+
+		// Completion and creation dates.
+		for len(fields) > 0 && todoTxtDate.MatchString(fields[0]) {
+			fields = fields[1:]
+		}
+
+		var (
+			words []string
+			notes []string
+		)
+
+		for _, field := range fields {
+			switch {
+			case strings.HasPrefix(field, "+") && len(field) > 1:
+				if task.Details.Project == "" {
+					task.Details.Project = field[1:]
+				} else {
+					task.Details.Tags = append(task.Details.Tags, field)
+				}
+			case strings.HasPrefix(field, "@") && len(field) > 1:
+				task.Details.Tags = append(task.Details.Tags, field[1:])
+			case todoTxtKeyValue.MatchString(field):
+				notes = append(notes, field)
+			default:
+				words = append(words, field)
+			}
+		}
+
+		if len(words) == 0 {
+			continue
+		}
+
+		task.Title = strings.Join(words, " ")
+		task.Details.Notes = strings.Join(notes, "\n")
+
+		tasks = append(tasks, task)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read todo.txt: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func parseCSV(r io.Reader) ([]Task, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("CSV has no title column")
+	}
+
+	var tasks []Task
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		column := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		task := Task{
+			Title: column("title"),
+			Details: core.TaskDetails{
+				Project: column("project"),
+				Notes:   column("notes"),
+			},
+			Status: event.TaskStatus(column("status")),
+		}
+
+		if task.Title == "" {
+			continue
+		}
+
+		if tags := column("tags"); tags != "" {
+			task.Details.Tags = strings.FieldsFunc(tags, func(r rune) bool {
+				return r == ',' || r == ';' || r == ' '
+			})
+		}
+
+		if estimate := column("estimate"); estimate != "" {
+			if task.Details.EstimatedPomodoros, err = strconv.Atoi(estimate); err != nil {
+				return nil, fmt.Errorf("line %d: invalid estimate: %s", line, estimate)
+			}
+		}
+
+		switch task.Status {
+		case "", event.TaskStatusTodo:
+			task.Status = ""
+		case event.TaskStatusInProgress, event.TaskStatusDone, event.TaskStatusArchived:
+		default:
+			return nil, fmt.Errorf("line %d: unknown status: %s", line, task.Status)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
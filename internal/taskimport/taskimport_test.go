@@ -0,0 +1,134 @@
+package taskimport_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hatappi/gomodoro/internal/core"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/taskimport"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		format taskimport.Format
+		input  string
+		want   []taskimport.Task
+	}{
+		{
+			name:   "markdown",
+			format: taskimport.FormatMarkdown,
+			input: `# Notes
+- [ ] write docs #docs
+Some text
+- not a task
+
+## gomodoro
+* [x] release v2
+  - [ ] announce #blog #social
+`,
+			want: []taskimport.Task{
+				{Title: "write docs", Details: core.TaskDetails{Project: "Notes", Tags: []string{"docs"}}},
+				{Title: "release v2", Details: core.TaskDetails{Project: "gomodoro"}, Status: event.TaskStatusDone},
+				{Title: "announce", Details: core.TaskDetails{Project: "gomodoro", Tags: []string{"blog", "social"}}},
+			},
+		},
+		{
+			name:   "todo.txt",
+			format: taskimport.FormatTodoTxt,
+			input: `(A) 2025-01-02 call mom +family @phone due:2025-01-05
+x 2025-01-03 2025-01-01 file taxes +home +money
+
+plain task
+`,
+			want: []taskimport.Task{
+				{
+					Title: "call mom",
+					Details: core.TaskDetails{
+						Project: "family",
+						Tags:    []string{"priority:A", "phone"},
+						Notes:   "due:2025-01-05",
+					},
+				},
+				{
+					Title:   "file taxes",
+					Details: core.TaskDetails{Project: "home", Tags: []string{"+money"}},
+					Status:  event.TaskStatusDone,
+				},
+				{Title: "plain task"},
+			},
+		},
+		{
+			name:   "csv",
+			format: taskimport.FormatCSV,
+			input: `Title,Project,Tags,Estimate,Notes,Status
+write docs,gomodoro,"docs;writing",3,see **issue**,in_progress
+,skipped,,,,
+review,,,,,todo
+`,
+			want: []taskimport.Task{
+				{
+					Title: "write docs",
+					Details: core.TaskDetails{
+						Project:            "gomodoro",
+						Tags:               []string{"docs", "writing"},
+						EstimatedPomodoros: 3,
+						Notes:              "see **issue**",
+					},
+					Status: event.TaskStatusInProgress,
+				},
+				{Title: "review"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := taskimport.Parse(strings.NewReader(tt.input), tt.format)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCSVInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"project\ngomodoro\n",
+		"title,estimate\nwrite docs,many\n",
+		"title,status\nwrite docs,blocked\n",
+	} {
+		if _, err := taskimport.Parse(strings.NewReader(input), taskimport.FormatCSV); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	t.Parallel()
+
+	tasks := []taskimport.Task{{Title: "Write docs"}, {Title: "review"}, {Title: "Review"}}
+	existing := []*core.Task{{ID: "a", Title: "write docs"}}
+
+	fresh, duplicates := taskimport.Dedupe(tasks, existing)
+
+	if len(fresh) != 1 || fresh[0].Title != "review" {
+		t.Errorf("fresh = %+v, want [review]", fresh)
+	}
+
+	if len(duplicates) != 2 || duplicates[0].Title != "Write docs" || duplicates[1].Title != "Review" {
+		t.Errorf("duplicates = %+v, want [Write docs Review]", duplicates)
+	}
+}
@@ -24,8 +24,8 @@ type TimeEntry struct {
 	TAGS        []string `json:"tags"`
 }
 
-// PostTimeEntry record duration with description.
-func (c *Client) PostTimeEntry(ctx context.Context, desc string, start time.Time, duration int) error {
+// PostTimeEntry record duration with description and tags.
+func (c *Client) PostTimeEntry(ctx context.Context, desc string, tags []string, start time.Time, duration int) error {
 	timeEntry := &TimeEntry{
 		Description: desc,
 		CreatedWith: appName,
@@ -33,6 +33,7 @@ func (c *Client) PostTimeEntry(ctx context.Context, desc string, start time.Time
 		Duration:    duration,
 		WorkspaceID: c.workspaceID,
 		ProjectID:   c.projectID,
+		TAGS:        tags,
 	}
 
 	jsonBytes, err := json.Marshal(timeEntry)
@@ -15,6 +15,7 @@ import (
 	"github.com/hatappi/gomodoro/internal/core/event"
 	gomodoro_error "github.com/hatappi/gomodoro/internal/errors"
 	"github.com/hatappi/gomodoro/internal/notify"
+	"github.com/hatappi/gomodoro/internal/sound"
 	"github.com/hatappi/gomodoro/internal/tui/constants"
 	"github.com/hatappi/gomodoro/internal/tui/screen"
 	"github.com/hatappi/gomodoro/internal/tui/view"
@@ -25,6 +26,16 @@ const (
 	continueTimerSignal = -1 // Signal to continue timer processing
 )
 
+// Keys of the notification actions.
+const (
+	notificationActionStart = "start"
+	notificationActionSkip  = "skip"
+
+	// notificationReplaceKey makes each notification of the TUI replace the previous one.
+	notificationReplaceKey = "tui"
+	notificationIcon       = "appointment-soon"
+)
+
 // App is the main TUI application controller.
 type App struct {
 	// Configuration and clients
@@ -39,12 +50,18 @@ type App struct {
 	errorView    *view.ErrorView
 
 	// Pomodoro settings
-	workSec       int
-	shortBreakSec int
-	longBreakSec  int
+	workSec        int
+	shortBreakSec  int
+	longBreakSec   int
+	breakFrequency int
 
 	// Completion handlers
-	completeFuncs []func(ctx context.Context, taskName string, isWorkTime bool, elapsedTime int)
+	completeFuncs []func(ctx context.Context, task *core.Task, isWorkTime bool, elapsedTime int)
+
+	notifier *notify.Notifier
+	player   *sound.Player
+	// ticks hands the ticks to the worker that plays them, so that only one tick is played at a time.
+	ticks chan struct{}
 }
 
 // Option is a function that configures the App.
@@ -71,20 +88,44 @@ func WithLongBreakSec(s int) Option {
 	}
 }
 
+// WithBreakFrequency sets the number of work sessions between long breaks.
+func WithBreakFrequency(n int) Option {
+	return func(a *App) {
+		a.breakFrequency = n
+	}
+}
+
 // WithNotify adds desktop notification functionality.
+// Where supported, the notification has buttons that start the next session or skip the break.
 func WithNotify() Option {
 	return func(a *App) {
+		a.notifier = notify.New()
 		a.completeFuncs = append(
 			a.completeFuncs,
-			func(ctx context.Context, taskName string, isWorkTime bool, _ int) {
-				var message string
+			func(ctx context.Context, task *core.Task, isWorkTime bool, _ int) {
+				n := notify.Notification{
+					Title:      "gomodoro",
+					Icon:       notificationIcon,
+					ReplaceKey: notificationReplaceKey,
+					// With sounds, the TUI plays its own chime, which follows its mute.
+					Silent: a.player != nil,
+					OnAction: func(key string) {
+						a.handleNotificationAction(ctx, task, key)
+					},
+				}
+
 				if isWorkTime {
-					message = "Finish work time"
+					n.Message = task.Title + ":Finish work time"
+					n.Actions = []notify.Action{
+						{Key: notificationActionStart, Label: "Start break"},
+						{Key: notificationActionSkip, Label: "Skip"},
+					}
 				} else {
-					message = "Finish break time"
+					n.Message = task.Title + ":Finish break time"
+					n.Actions = []notify.Action{{Key: notificationActionStart, Label: "Start work"}}
 				}
 
-				if err := notify.Notify("gomodoro", taskName+":"+message); err != nil {
+				if err := a.notifier.Notify(ctx, n); err != nil {
 					log.FromContext(ctx).Error(err, "failed to notify")
 				}
 			},
@@ -92,6 +133,13 @@ func WithNotify() Option {
 	}
 }
 
+// WithSound plays the sounds of the player when a phase ends and, if enabled, every second of work.
+func WithSound(player *sound.Player) Option {
+	return func(a *App) {
+		a.player = player
+	}
+}
+
 // NewApp creates a new TUI application instance.
 func NewApp(cfg *config.Config, gqlClient *graphql.ClientWrapper, opts ...Option) (*App, error) {
 	terminalScreen, err := screen.NewScreen(cfg)
@@ -101,12 +149,13 @@ func NewApp(cfg *config.Config, gqlClient *graphql.ClientWrapper, opts ...Option
 	screenClient := screen.NewClient(terminalScreen)
 
 	app := &App{
-		config:        cfg,
-		screenClient:  screenClient,
-		graphqlClient: gqlClient,
-		workSec:       config.DefaultWorkSec,
-		shortBreakSec: config.DefaultShortBreakSec,
-		longBreakSec:  config.DefaultLongBreakSec,
+		config:         cfg,
+		screenClient:   screenClient,
+		graphqlClient:  gqlClient,
+		workSec:        config.DefaultWorkSec,
+		shortBreakSec:  config.DefaultShortBreakSec,
+		longBreakSec:   config.DefaultLongBreakSec,
+		breakFrequency: config.DefaultBreakFrequency,
 	}
 
 	// Apply all options
@@ -120,6 +169,14 @@ func NewApp(cfg *config.Config, gqlClient *graphql.ClientWrapper, opts ...Option
 	app.pomodoroView = view.NewPomodoroView(cfg, screenClient)
 	app.errorView = view.NewErrorView(cfg, screenClient)
 
+	if app.player != nil {
+		app.timerView.SetSound(true, app.player.Muted())
+
+		if app.player.TickEnabled() {
+			app.ticks = make(chan struct{})
+		}
+	}
+
 	return app, nil
 }
 
@@ -130,6 +187,10 @@ func (a *App) Run(ctx context.Context) error {
 
 	a.screenClient.StartPollEvent(ctx)
 
+	if a.ticks != nil {
+		go a.playTicks(ctx)
+	}
+
 	connectionErrChan, err := a.graphqlClient.ConnectSubscription(ctx)
 	if err != nil {
 		return err
@@ -153,6 +214,9 @@ func (a *App) Run(ctx context.Context) error {
 		return err
 	}
 
+	// started is the session that was started outside the TUI while it waited for the next action.
+	var started *event.PomodoroEvent
+
 	for {
 		type timerResult struct {
 			elapsedTime int
@@ -164,14 +228,17 @@ func (a *App) Run(ctx context.Context) error {
 			resultCh <- timerResult{elapsedTime: elapsedTime, err: err}
 		}()
 
-		pomodoro, err := a.graphqlClient.StartPomodoro(ctx, gqlgen.StartPomodoroInput{
-			WorkDurationSec:      a.workSec,
-			BreakDurationSec:     a.shortBreakSec,
-			LongBreakDurationSec: a.longBreakSec,
-			TaskId:               task.ID,
-		})
-		if err != nil {
-			return err
+		var phase event.PomodoroPhase
+		if started != nil {
+			phase = started.Phase
+			started = nil
+		} else {
+			pomodoro, err := a.graphqlClient.StartPomodoro(ctx, a.startPomodoroInput(task, false))
+			if err != nil {
+				return err
+			}
+
+			phase = pomodoro.Phase
 		}
 
 		res := <-resultCh
@@ -181,12 +248,16 @@ func (a *App) Run(ctx context.Context) error {
 
 		log.FromContext(ctx).V(1).Info("Pomodoro finished", "elapsedTime", res.elapsedTime, "err", nil)
 
+		// Watch before the completion functions run, so that a session started from a notification is not missed.
+		startedCh, stopWatching := a.watchStarted(ctx)
+
 		// Execute completion functions
 		for _, cf := range a.completeFuncs {
-			go cf(ctx, task.Title, pomodoro.Phase == event.PomodoroPhaseWork, res.elapsedTime)
+			go cf(ctx, task, phase == event.PomodoroPhaseWork, res.elapsedTime)
 		}
 
-		action, err := a.pomodoroView.SelectNextTask(ctx)
+		action, startedEvent, err := a.selectNextAction(ctx, startedCh)
+		stopWatching()
 		if err != nil {
 			return err
 		}
@@ -216,6 +287,9 @@ func (a *App) Run(ctx context.Context) error {
 				return err
 			}
 			task = newTask
+		case constants.PomodoroActionStarted:
+			// Follow the session started outside the TUI
+			started = startedEvent
 		case constants.PomodoroActionNone:
 			// no action
 		}
@@ -223,8 +297,105 @@ func (a *App) Run(ctx context.Context) error {
 }
 
 // Finish cleans up resources when the app is closed.
-func (a *App) Finish(_ context.Context) {
+func (a *App) Finish(ctx context.Context) {
 	a.screenClient.Finish()
+
+	if a.notifier != nil {
+		if err := a.notifier.Close(); err != nil {
+			log.FromContext(ctx).Error(err, "failed to close notifier")
+		}
+	}
+
+	if a.player != nil {
+		if err := a.player.Close(); err != nil {
+			log.FromContext(ctx).Error(err, "failed to close sound player")
+		}
+	}
+}
+
+func (a *App) startPomodoroInput(task *core.Task, skipBreak bool) gqlgen.StartPomodoroInput {
+	return gqlgen.StartPomodoroInput{
+		WorkDurationSec:      a.workSec,
+		BreakDurationSec:     a.shortBreakSec,
+		LongBreakDurationSec: a.longBreakSec,
+		BreakFrequency:       a.breakFrequency,
+		TaskId:               task.ID,
+		SkipBreak:            skipBreak,
+	}
+}
+
+// handleNotificationAction starts the next session through the API when a notification button is clicked.
+// The TUI follows the session once it has started, see selectNextAction.
+func (a *App) handleNotificationAction(ctx context.Context, task *core.Task, key string) {
+	if key != notificationActionStart && key != notificationActionSkip {
+		return
+	}
+
+	input := a.startPomodoroInput(task, key == notificationActionSkip)
+	if _, err := a.graphqlClient.StartPomodoro(ctx, input); err != nil {
+		log.FromContext(ctx).Error(err, "failed to start pomodoro from notification")
+	}
+}
+
+// watchStarted subscribes to started sessions. Without the subscription, the returned channel is nil.
+func (a *App) watchStarted(ctx context.Context) (<-chan event.EventInfo, func()) {
+	eventChan, _, subID, err := a.graphqlClient.SubscribeToEvents(ctx, gqlgen.EventReceivedInput{
+		EventCategory: []gqlgen.EventCategory{gqlgen.EventCategoryPomodoro},
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to subscribe to started pomodoros")
+		return nil, func() {}
+	}
+
+	return eventChan, func() {
+		if err := a.graphqlClient.Unsubscribe(subID); err != nil {
+			log.FromContext(ctx).Error(err, "failed to unsubscribe from events")
+		}
+	}
+}
+
+// selectNextAction waits for the action chosen after a session,
+// or returns PomodoroActionStarted with the event of a session started outside the TUI, e.g. from a notification.
+func (a *App) selectNextAction(
+	ctx context.Context,
+	startedCh <-chan event.EventInfo,
+) (constants.PomodoroAction, *event.PomodoroEvent, error) {
+	selectCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type selection struct {
+		action constants.PomodoroAction
+		err    error
+	}
+	selectionCh := make(chan selection, 1)
+	go func() {
+		action, err := a.pomodoroView.SelectNextTask(selectCtx)
+		selectionCh <- selection{action: action, err: err}
+	}()
+
+	for {
+		select {
+		case s := <-selectionCh:
+			return s.action, nil, s.err
+		case e, ok := <-startedCh:
+			if !ok {
+				startedCh = nil
+				continue
+			}
+
+			ev, ok := e.(event.PomodoroEvent)
+			if !ok || ev.Type != event.PomodoroStarted {
+				continue
+			}
+
+			// Wait for the view to stop reading screen events before the timer takes over.
+			cancel()
+			<-selectionCh
+			a.screenClient.Clear()
+
+			return constants.PomodoroActionStarted, &ev, nil
+		}
+	}
 }
 
 // selectTask handles task selection and creation.
@@ -248,6 +419,8 @@ func (a *App) selectTask(ctx context.Context, resetCursorPosition bool) (*core.T
 		return nil, gomodoro_error.ErrCancel
 	case constants.TaskActionDelete:
 		return a.handleDeleteTask(ctx, task)
+	case constants.TaskActionComplete:
+		return a.handleCompleteTask(ctx, task)
 	case constants.TaskActionNew:
 		return a.handleNewTask(ctx)
 	case constants.TaskActionNone:
@@ -281,6 +454,20 @@ func (a *App) handleDeleteTask(ctx context.Context, task *core.Task) (*core.Task
 	return a.selectTask(ctx, false)
 }
 
+// handleCompleteTask marks a task as done and returns a new selected task.
+func (a *App) handleCompleteTask(ctx context.Context, task *core.Task) (*core.Task, error) {
+	if task == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	if _, err := a.graphqlClient.CompleteTask(ctx, task.ID); err != nil {
+		return nil, err
+	}
+
+	return a.selectTask(ctx, false)
+}
+
 // handleNewTask creates a new task.
 func (a *App) handleNewTask(ctx context.Context) (*core.Task, error) {
 	name, err := a.taskView.CreateTaskName(ctx)
@@ -288,7 +475,7 @@ func (a *App) handleNewTask(ctx context.Context) (*core.Task, error) {
 		return nil, err
 	}
 
-	task, err := a.graphqlClient.CreateTask(ctx, name)
+	task, err := a.graphqlClient.CreateTask(ctx, name, core.TaskDetails{})
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +575,10 @@ func (a *App) handleScreenEvent(ctx context.Context, e interface{}) (int, error)
 		}
 	case constants.TimerActionToggle:
 		a.toggleTimer(ctx)
+	case constants.TimerActionToggleSound:
+		if a.player != nil {
+			a.timerView.SetSound(true, a.player.ToggleMute())
+		}
 	case constants.TimerActionNone:
 		// no action
 	}
@@ -399,9 +590,19 @@ func (a *App) handleScreenEvent(ctx context.Context, e interface{}) (int, error)
 func (a *App) handlePomodoroEvent(ctx context.Context, ev event.PomodoroEvent, taskName string) (int, error) {
 	log.FromContext(ctx).V(1).Info("event", "event", ev, "remainSec", ev.RemainingTime.Seconds())
 
+	a.playSound(ctx, ev)
+
 	remainSec := int(ev.RemainingTime.Seconds())
 
-	err := a.timerView.DrawTimer(ctx, remainSec, taskName, ev.Phase, ev.Type == event.PomodoroPaused)
+	err := a.timerView.DrawTimer(
+		ctx,
+		remainSec,
+		taskName,
+		ev.Phase,
+		core.CyclePosition(ev.PhaseCount, ev.BreakFrequency),
+		ev.BreakFrequency,
+		ev.Type == event.PomodoroPaused,
+	)
 	if err != nil {
 		if !errors.Is(err, gomodoro_error.ErrScreenSmall) {
 			return 0, err
@@ -423,6 +624,63 @@ func (a *App) handlePomodoroEvent(ctx context.Context, ev event.PomodoroEvent, t
 	return continueTimerSignal, nil // Signal to continue processing
 }
 
+// playSound plays the sound of an event in the background: a chime when a phase is completed,
+// and a tick every second of work if ticks are enabled.
+// A tick is dropped while the previous one is still playing, so a slow audio player does not pile up.
+func (a *App) playSound(ctx context.Context, ev event.PomodoroEvent) {
+	if a.player == nil {
+		return
+	}
+
+	var s sound.Sound
+
+	switch {
+	case ev.Type == event.PomodoroCompleted && ev.Phase == event.PomodoroPhaseWork:
+		s = sound.PhaseEnd
+	case ev.Type == event.PomodoroCompleted:
+		s = sound.BreakEnd
+	case ev.Type == event.PomodoroTick && ev.Phase == event.PomodoroPhaseWork && a.player.TickEnabled():
+		select {
+		case a.ticks <- struct{}{}:
+		default:
+		}
+
+		return
+	default:
+		return
+	}
+
+	go func() {
+		if err := a.player.Play(ctx, s); err != nil {
+			log.FromContext(ctx).Error(err, "failed to play sound", "sound", s)
+		}
+	}()
+}
+
+// playTicks plays the ticks received from a.ticks one at a time until ctx is done.
+// Ticks are disabled when no audio player is installed, so that the error is logged once instead of every second.
+func (a *App) playTicks(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-a.ticks:
+		}
+
+		err := a.player.Play(ctx, sound.Tick)
+		if errors.Is(err, sound.ErrNoPlayer) {
+			a.player.DisableTick()
+			log.FromContext(ctx).Error(err, "failed to play sound, ticks are disabled")
+
+			return
+		}
+
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to play sound", "sound", sound.Tick)
+		}
+	}
+}
+
 // handleSmallScreen handles the case when the screen is too small.
 func (a *App) handleSmallScreen(ctx context.Context, taskName string) (int, error) {
 	a.screenClient.Clear()
@@ -13,6 +13,8 @@ const (
 	TimerActionToggle TimerAction = "timer:toggle"
 	// TimerActionStop indicates the timer should stop.
 	TimerActionStop TimerAction = "timer:stop"
+	// TimerActionToggleSound indicates the sounds should be muted or unmuted.
+	TimerActionToggleSound TimerAction = "timer:toggle_sound"
 )
 
 // TaskAction represents task-specific actions.
@@ -27,6 +29,8 @@ const (
 	TaskActionNew TaskAction = "task:new"
 	// TaskActionDelete indicates a task should be deleted.
 	TaskActionDelete TaskAction = "task:delete"
+	// TaskActionComplete indicates a task should be marked as done.
+	TaskActionComplete TaskAction = "task:complete"
 )
 
 // PomodoroAction represents pomodoro-specific actions.
@@ -43,4 +47,6 @@ const (
 	PomodoroActionChange PomodoroAction = "pomodoro:change"
 	// PomodoroActionReset indicates the pomodoro should be reset.
 	PomodoroActionReset PomodoroAction = "pomodoro:reset"
+	// PomodoroActionStarted indicates the next pomodoro was started outside the TUI, e.g. from a notification.
+	PomodoroActionStarted PomodoroAction = "pomodoro:started"
 )
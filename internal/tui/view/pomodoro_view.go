@@ -26,7 +26,8 @@ func NewPomodoroView(cfg *config.Config, sc screen.Client) *PomodoroView {
 }
 
 // SelectNextTask displays options for continuing or changing tasks after a pomodoro cycle.
-func (v *PomodoroView) SelectNextTask(_ context.Context) (constants.PomodoroAction, error) {
+// It returns ctx.Err() when ctx is done before an option is chosen.
+func (v *PomodoroView) SelectNextTask(ctx context.Context) (constants.PomodoroAction, error) {
 	w, h := v.screenClient.ScreenSize()
 	draw.Sentence(
 		v.screenClient.GetScreen(),
@@ -39,7 +40,13 @@ func (v *PomodoroView) SelectNextTask(_ context.Context) (constants.PomodoroActi
 	)
 
 	for {
-		e := <-v.screenClient.GetEventChan()
+		var e interface{}
+		select {
+		case <-ctx.Done():
+			return constants.PomodoroActionNone, ctx.Err()
+		case e = <-v.screenClient.GetEventChan():
+		}
+
 		switch e := e.(type) {
 		case screen.EventEnter:
 			return constants.PomodoroActionContinue, nil
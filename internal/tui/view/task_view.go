@@ -24,6 +24,9 @@ type TaskView struct {
 
 	selectCursor int
 	selectOffset int
+
+	// showAll also lists done and archived tasks.
+	showAll bool
 }
 
 // NewTaskView creates a new task view instance.
@@ -35,13 +38,16 @@ func NewTaskView(cfg *config.Config, sc screen.Client) *TaskView {
 }
 
 // SelectTask displays the task selection UI and returns the selected task.
+// Done and archived tasks are hidden unless the user toggles them on.
 func (v *TaskView) SelectTask(
 	_ context.Context,
-	tasks []*core.Task,
+	allTasks []*core.Task,
 	resetCursorPosition bool,
 ) (*core.Task, constants.TaskAction, error) {
 	v.screenClient.Clear()
 
+	tasks := v.visibleTasks(allTasks)
+
 	if resetCursorPosition || len(tasks) == 0 {
 		v.selectOffset = 0
 		v.selectCursor = 0
@@ -57,6 +63,10 @@ func (v *TaskView) SelectTask(
 		case screen.EventCancel:
 			return nil, constants.TaskActionCancel, gomodoro_error.ErrCancel
 		case screen.EventEnter:
+			if len(renderedTasks) == 0 {
+				continue
+			}
+
 			return renderedTasks[v.selectCursor], constants.TaskActionNone, nil
 		case screen.EventKeyDown:
 			v.selectCursor++
@@ -100,7 +110,23 @@ func (v *TaskView) SelectTask(
 				v.screenClient.Clear()
 				return nil, constants.TaskActionNew, nil
 			case "d":
+				if len(renderedTasks) == 0 {
+					continue
+				}
+
 				return renderedTasks[v.selectCursor], constants.TaskActionDelete, nil
+			case "x":
+				if len(renderedTasks) == 0 || !renderedTasks[v.selectCursor].IsOpen() {
+					continue
+				}
+
+				return renderedTasks[v.selectCursor], constants.TaskActionComplete, nil
+			case "a":
+				v.showAll = !v.showAll
+				tasks = v.visibleTasks(allTasks)
+				v.selectOffset = 0
+				v.selectCursor = 0
+				v.screenClient.Clear()
 			}
 		case screen.EventScreenResize:
 			renderableHeight := v.getSelectRenderableHeight()
@@ -156,6 +182,9 @@ func (v *TaskView) renderTasks(tasks []*core.Task, offset, renderbleNum, cursorP
 	renderedTasks := make([]*core.Task, 0, limit-offset)
 	for y, t := range tasks[offset:limit] {
 		name := fmt.Sprintf("%3d: %s", offset+y+1, t.Title)
+		if !t.IsOpen() {
+			name += fmt.Sprintf(" (%s)", t.Status)
+		}
 		opts := []draw.Option{}
 		if y == cursorPosition {
 			opts = []draw.Option{
@@ -177,7 +206,7 @@ func (v *TaskView) renderTasks(tasks []*core.Task, offset, renderbleNum, cursorP
 		0,
 		h-1,
 		w,
-		"(n): add new task / (d): delete task",
+		"(n): add new task / (d): delete task / (x): mark done / (a): show all",
 		true,
 		draw.WithBackgroundColor(v.config.Color.StatusBarBackground),
 	)
@@ -185,6 +214,22 @@ func (v *TaskView) renderTasks(tasks []*core.Task, offset, renderbleNum, cursorP
 	return renderedTasks
 }
 
+// visibleTasks returns the tasks to list, hiding done and archived ones unless showAll is set.
+func (v *TaskView) visibleTasks(tasks []*core.Task) []*core.Task {
+	if v.showAll {
+		return tasks
+	}
+
+	visible := make([]*core.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if t.IsOpen() {
+			visible = append(visible, t)
+		}
+	}
+
+	return visible
+}
+
 func (v *TaskView) getSelectRenderableHeight() int {
 	_, h := v.screenClient.ScreenSize()
 	if h <= 1 {
@@ -3,6 +3,7 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -22,6 +23,9 @@ import (
 type TimerView struct {
 	config       *config.Config
 	screenClient screen.Client
+
+	// soundKey is the help of the sound key in the status bar, empty without sounds.
+	soundKey string
 }
 
 // NewTimerView creates a new timer view instance.
@@ -38,11 +42,14 @@ const (
 )
 
 // DrawTimer renders the timer UI with the current time and state.
+// cyclePosition and breakFrequency show which work session of the long break cycle is running.
 func (v *TimerView) DrawTimer(
 	ctx context.Context,
 	duration int,
 	title string,
 	phase event.PomodoroPhase,
+	cyclePosition int,
+	breakFrequency int,
 	isPaused bool,
 ) error {
 	screen := v.screenClient.GetScreen()
@@ -83,6 +90,10 @@ func (v *TimerView) DrawTimer(
 
 	v.screenClient.Clear()
 
+	if breakFrequency > 0 {
+		title = fmt.Sprintf("[%s %d/%d] %s", phase, cyclePosition, breakFrequency, title)
+	}
+
 	draw.Sentence(screen, x, y, int(timerWidth), title, true)
 
 	var bc tcell.Color
@@ -107,9 +118,9 @@ func (v *TimerView) DrawTimer(
 	draw.Sentence(
 		screen,
 		0,
-		screenWidth-1,
-		screenHeight,
-		"(e): end timer / (Enter): stop start timer",
+		screenHeight-1,
+		screenWidth,
+		"(e): end timer / (Enter): stop start timer"+v.soundKey,
 		true,
 		draw.WithBackgroundColor(v.config.Color.StatusBarBackground),
 	)
@@ -117,14 +128,29 @@ func (v *TimerView) DrawTimer(
 	return nil
 }
 
+// SetSound shows the key that toggles the sounds in the status bar, or hides it if enabled is false.
+func (v *TimerView) SetSound(enabled, muted bool) {
+	switch {
+	case !enabled:
+		v.soundKey = ""
+	case muted:
+		v.soundKey = " / (m): unmute"
+	default:
+		v.soundKey = " / (m): mute"
+	}
+}
+
 // HandleScreenEvent processes user input events.
 func (v *TimerView) HandleScreenEvent(_ context.Context, e interface{}) (constants.TimerAction, error) {
 	switch ev := e.(type) {
 	case screen.EventCancel:
 		return constants.TimerActionCancel, gomodoro_error.ErrCancel
 	case screen.EventRune:
-		if string(ev) == "e" {
+		switch string(ev) {
+		case "e":
 			return constants.TimerActionStop, nil
+		case "m":
+			return constants.TimerActionToggleSound, nil
 		}
 	case screen.EventEnter:
 		return constants.TimerActionToggle, nil
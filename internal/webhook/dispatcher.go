@@ -0,0 +1,257 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatappi/go-kit/log"
+
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/httppost"
+	"github.com/hatappi/gomodoro/internal/storage"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+)
+
+// Dispatcher delivers the events of the bus to the webhooks.
+// A delivery that still fails after the configured attempts is stored in the dead-letter queue.
+type Dispatcher struct {
+	webhooks    []*Webhook
+	deadLetters storage.DeadLetterStorage
+
+	httpclient     httppost.Doer
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	now            func() time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Option represents a function that configures the Dispatcher.
+type Option func(*Dispatcher)
+
+// WithHTTPClient sets the HTTP client used for deliveries.
+func WithHTTPClient(client *http.Client) Option {
+	return func(d *Dispatcher) {
+		d.httpclient = client
+	}
+}
+
+// WithBackoff sets the wait before the first retry, which doubles on each retry up to maxBackoff.
+func WithBackoff(initial, maxBackoff time.Duration) Option {
+	return func(d *Dispatcher) {
+		d.initialBackoff = initial
+		d.maxBackoff = maxBackoff
+	}
+}
+
+// NewDispatcher creates a new Dispatcher that stores failed deliveries in deadLetters.
+func NewDispatcher(webhooks []*Webhook, deadLetters storage.DeadLetterStorage, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		webhooks:       webhooks,
+		deadLetters:    deadLetters,
+		httpclient:     &http.Client{},
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		now:            time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Start subscribes the webhooks to the bus and delivers events until ctx is done or Stop is called.
+// Events published before this call are not delivered.
+func (d *Dispatcher) Start(ctx context.Context, bus event.EventBus) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	var eventTypes []event.EventType
+	for _, eventType := range event.AllEventTypes {
+		for _, w := range d.webhooks {
+			if w.Subscribes(eventType) {
+				eventTypes = append(eventTypes, eventType)
+				break
+			}
+		}
+	}
+
+	if len(eventTypes) == 0 {
+		return
+	}
+
+	busCh, unsubscribe := bus.SubscribeChannel(eventTypes, event.WithSubscriberName("webhooks"))
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-busCh:
+				if !ok {
+					return
+				}
+
+				if info, ok := e.(event.EventInfo); ok {
+					d.dispatch(ctx, info)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops delivering events and waits until the pending deliveries have been stored
+// in the dead-letter queue or ctx is done.
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel != nil {
+		d.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for pending webhook deliveries: %w", ctx.Err())
+	}
+}
+
+// dispatch delivers an event to every URL of the webhooks that subscribe to it.
+// Each URL is delivered on its own, so a slow endpoint does not hold up the others.
+func (d *Dispatcher) dispatch(ctx context.Context, e event.EventInfo) {
+	for _, w := range d.webhooks {
+		if !w.Subscribes(e.GetEventType()) {
+			continue
+		}
+
+		body, err := w.Render(e, false)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to render webhook payload", "webhook", w.Name)
+			continue
+		}
+
+		for _, url := range w.URLs {
+			d.wg.Add(1)
+			go func() {
+				defer d.wg.Done()
+
+				if err := d.Deliver(ctx, w, url, e.GetEventType(), body); err != nil {
+					log.FromContext(ctx).Error(err, "Failed to deliver webhook", "webhook", w.Name, "url", url)
+				}
+			}()
+		}
+	}
+}
+
+// Deliver sends a payload to a URL of the webhook, retrying with backoff.
+// If every attempt fails, the delivery is stored in the dead-letter queue and the last error is returned.
+// A delivery interrupted by ctx is stored as well, so it can be retried later.
+func (d *Dispatcher) Deliver(ctx context.Context, w *Webhook, url string, eventType event.EventType, body []byte) error {
+	deliveryID := uuid.NewString()
+	backoff := d.initialBackoff
+
+	var (
+		attempts int
+		err      error
+	)
+
+	for attempts < w.MaxAttempts {
+		if attempts > 0 {
+			select {
+			case <-ctx.Done():
+				err = fmt.Errorf("delivery interrupted: %w", errors.Join(ctx.Err(), err))
+				return d.deadLetter(w, url, deliveryID, eventType, body, attempts, err)
+			case <-time.After(backoff):
+			}
+
+			backoff = min(backoff*2, d.maxBackoff) //nolint:mnd
+		}
+
+		attempts++
+
+		err = d.Send(ctx, w, url, deliveryID, eventType, body)
+		if err == nil {
+			return nil
+		}
+
+		var statusErr *httppost.StatusError
+		if errors.As(err, &statusErr) && !statusErr.Retryable() {
+			break
+		}
+	}
+
+	return d.deadLetter(w, url, deliveryID, eventType, body, attempts, err)
+}
+
+// Send makes a single attempt to deliver a payload to a URL of the webhook.
+func (d *Dispatcher) Send(
+	ctx context.Context,
+	w *Webhook,
+	url, deliveryID string,
+	eventType event.EventType,
+	body []byte,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(EventHeader, string(eventType))
+	header.Set(DeliveryHeader, deliveryID)
+
+	if w.Secret != "" {
+		header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	log.FromContext(ctx).V(1).Info("request: deliver webhook", "webhook", w.Name, "url", url, "event", eventType)
+
+	return httppost.Post(ctx, d.httpclient, url, header, body)
+}
+
+func (d *Dispatcher) deadLetter(
+	w *Webhook,
+	url, deliveryID string,
+	eventType event.EventType,
+	body []byte,
+	attempts int,
+	deliveryErr error,
+) error {
+	letter := &storage.DeadLetter{
+		ID:         uuid.NewString(),
+		Webhook:    w.Name,
+		DeliveryID: deliveryID,
+		URL:        url,
+		EventType:  string(eventType),
+		Payload:    body,
+		Attempts:   attempts,
+		LastError:  deliveryErr.Error(),
+		CreatedAt:  d.now(),
+	}
+
+	if err := d.deadLetters.AddDeadLetter(letter); err != nil {
+		return fmt.Errorf("failed to store dead letter: %w, delivery error: %w", err, deliveryErr)
+	}
+
+	return fmt.Errorf("moved to the dead-letter queue after %d attempts: %w", attempts, deliveryErr)
+}
@@ -0,0 +1,247 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/storage"
+	"github.com/hatappi/gomodoro/internal/storage/memory"
+	"github.com/hatappi/gomodoro/internal/webhook"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver is a local webhook endpoint that answers with the given statuses in turn, then 204.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	requests []request
+	received chan struct{}
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+
+	r := &receiver{statuses: statuses, received: make(chan struct{}, 100)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		r.requests = append(r.requests, request{header: req.Header.Clone(), body: body})
+		status := http.StatusNoContent
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+		r.received <- struct{}{}
+	}))
+	t.Cleanup(r.Close)
+
+	return r
+}
+
+func (r *receiver) wait(t *testing.T, n int) []request {
+	t.Helper()
+
+	for range n {
+		select {
+		case <-r.received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %d requests", n)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]request(nil), r.requests...)
+}
+
+func newWebhook(t *testing.T, cfg config.WebhookConfig) *webhook.Webhook {
+	t.Helper()
+
+	webhooks, err := webhook.New([]config.WebhookConfig{cfg})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	return webhooks[0]
+}
+
+func deadLetters(t *testing.T, s storage.DeadLetterStorage) []*storage.DeadLetter {
+	t.Helper()
+
+	letters, err := s.GetDeadLetters()
+	if err != nil {
+		t.Fatalf("GetDeadLetters() returned error: %v", err)
+	}
+
+	return letters
+}
+
+func TestDispatcherDeliver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	body := []byte(`{"type":"pomodoro.completed"}`)
+
+	t.Run("retries until success", func(t *testing.T) {
+		t.Parallel()
+
+		r := newReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		w := newWebhook(t, config.WebhookConfig{Name: "a", URLs: []string{r.URL}, Secret: "secret"})
+		store := memory.NewMemoryStorage()
+		d := webhook.NewDispatcher([]*webhook.Webhook{w}, store, webhook.WithBackoff(time.Millisecond, time.Millisecond))
+
+		if err := d.Deliver(ctx, w, r.URL, event.PomodoroCompleted, body); err != nil {
+			t.Fatalf("Deliver() returned error: %v", err)
+		}
+
+		requests := r.wait(t, 3)
+		for _, req := range requests {
+			if !webhook.Verify("secret", req.body, req.header.Get(webhook.SignatureHeader)) {
+				t.Errorf("request has invalid signature %q", req.header.Get(webhook.SignatureHeader))
+			}
+
+			if req.header.Get(webhook.EventHeader) != "pomodoro.completed" {
+				t.Errorf("request has event header %q", req.header.Get(webhook.EventHeader))
+			}
+
+			if req.header.Get(webhook.DeliveryHeader) != requests[0].header.Get(webhook.DeliveryHeader) {
+				t.Error("retries have different delivery IDs")
+			}
+		}
+
+		if letters := deadLetters(t, store); len(letters) != 0 {
+			t.Errorf("dead letters = %+v, want none", letters)
+		}
+	})
+
+	t.Run("dead letter after all attempts", func(t *testing.T) {
+		t.Parallel()
+
+		r := newReceiver(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+		w := newWebhook(t, config.WebhookConfig{Name: "a", URLs: []string{r.URL}, MaxAttempts: 2})
+		store := memory.NewMemoryStorage()
+		d := webhook.NewDispatcher([]*webhook.Webhook{w}, store, webhook.WithBackoff(time.Millisecond, time.Millisecond))
+
+		if err := d.Deliver(ctx, w, r.URL, event.PomodoroCompleted, body); err == nil {
+			t.Fatal("Deliver() returned no error")
+		}
+
+		requests := r.wait(t, 2)
+		if len(requests) != 2 {
+			t.Fatalf("received %d requests, want 2", len(requests))
+		}
+
+		letters := deadLetters(t, store)
+		if len(letters) != 1 {
+			t.Fatalf("dead letters = %+v, want 1", letters)
+		}
+
+		if l := letters[0]; l.Webhook != "a" || l.DeliveryID != requests[0].header.Get(webhook.DeliveryHeader) ||
+			l.URL != r.URL || l.EventType != "pomodoro.completed" ||
+			string(l.Payload) != string(body) || l.Attempts != 2 || l.LastError == "" {
+			t.Errorf("dead letter = %+v", l)
+		}
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		r := newReceiver(t, http.StatusBadRequest)
+		w := newWebhook(t, config.WebhookConfig{Name: "a", URLs: []string{r.URL}})
+		store := memory.NewMemoryStorage()
+		d := webhook.NewDispatcher([]*webhook.Webhook{w}, store, webhook.WithBackoff(time.Millisecond, time.Millisecond))
+
+		if err := d.Deliver(ctx, w, r.URL, event.PomodoroCompleted, body); err == nil {
+			t.Fatal("Deliver() returned no error")
+		}
+
+		r.wait(t, 1)
+
+		if letters := deadLetters(t, store); len(letters) != 1 || letters[0].Attempts != 1 {
+			t.Errorf("dead letters = %+v, want 1 after 1 attempt", letters)
+		}
+	})
+
+	t.Run("interrupted delivery is kept", func(t *testing.T) {
+		t.Parallel()
+
+		r := newReceiver(t, http.StatusInternalServerError)
+		w := newWebhook(t, config.WebhookConfig{Name: "a", URLs: []string{r.URL}})
+		store := memory.NewMemoryStorage()
+		d := webhook.NewDispatcher([]*webhook.Webhook{w}, store, webhook.WithBackoff(time.Hour, time.Hour))
+
+		ctx, cancel := context.WithCancel(ctx)
+		go func() {
+			r.wait(t, 1)
+			cancel()
+		}()
+
+		if err := d.Deliver(ctx, w, r.URL, event.PomodoroCompleted, body); err == nil {
+			t.Fatal("Deliver() returned no error")
+		}
+
+		if letters := deadLetters(t, store); len(letters) != 1 || letters[0].Attempts != 1 {
+			t.Errorf("dead letters = %+v, want 1 after 1 attempt", letters)
+		}
+	})
+}
+
+func TestDispatcherStart(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	completed := newReceiver(t)
+	started := newReceiver(t)
+
+	webhooks, err := webhook.New([]config.WebhookConfig{
+		{Name: "completed", URLs: []string{completed.URL}, Events: []string{"pomodoro.completed"}},
+		{Name: "started", URLs: []string{started.URL}, Events: []string{"pomodoro.started"}},
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	bus := event.NewInMemoryBus()
+	d := webhook.NewDispatcher(webhooks, memory.NewMemoryStorage())
+	d.Start(ctx, bus)
+
+	bus.Publish(event.PomodoroEvent{BaseEvent: event.BaseEvent{Type: event.PomodoroStarted, Timestamp: epoch}, ID: "p1"})
+	bus.Publish(event.PomodoroEvent{BaseEvent: event.BaseEvent{Type: event.PomodoroCompleted, Timestamp: epoch}, ID: "p1"})
+
+	if requests := started.wait(t, 1); requests[0].header.Get(webhook.EventHeader) != "pomodoro.started" {
+		t.Errorf("started webhook received %s", requests[0].header.Get(webhook.EventHeader))
+	}
+
+	if requests := completed.wait(t, 1); requests[0].header.Get(webhook.EventHeader) != "pomodoro.completed" {
+		t.Errorf("completed webhook received %s", requests[0].header.Get(webhook.EventHeader))
+	}
+
+	stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := d.Stop(stopCtx); err != nil {
+		t.Fatalf("Stop() returned error: %v", err)
+	}
+
+	if subscribers := bus.Stats().Subscribers; subscribers != 0 {
+		t.Errorf("bus has %d subscribers after Stop(), want 0", subscribers)
+	}
+}
@@ -0,0 +1,196 @@
+// Package webhook sends pomodoro and task events to HTTP endpoints
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/httppost"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 of the body as "sha256=<hex>" when the webhook has a secret.
+	SignatureHeader = "X-Gomodoro-Signature"
+	// EventHeader carries the event type.
+	EventHeader = "X-Gomodoro-Event"
+	// DeliveryHeader carries an ID that is unique to each delivery and stays the same across its attempts.
+	DeliveryHeader = "X-Gomodoro-Delivery"
+
+	// DefaultMaxAttempts is the number of attempts before a delivery goes to the dead-letter queue.
+	DefaultMaxAttempts = 4
+	// DefaultTimeout is the timeout of each attempt.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Webhook is a configured webhook.
+type Webhook struct {
+	Name        string
+	URLs        []string
+	EventTypes  []event.EventType
+	Secret      string
+	MaxAttempts int
+	Timeout     time.Duration
+
+	template *template.Template
+}
+
+// Payload is the data passed to the payload template, and the default payload.
+type Payload struct {
+	Webhook   string          `json:"webhook"`
+	Type      event.EventType `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	Sequence  int64           `json:"sequence,omitempty"`
+	// Test is true for the events sent by `gomodoro webhook test`.
+	Test bool `json:"test,omitempty"`
+	// Event is the event.PomodoroEvent or event.TaskEvent, e.g. {{ .Event.ID }}.
+	Event event.EventInfo `json:"data"`
+}
+
+// New creates the webhooks from the configuration.
+func New(cfgs []config.WebhookConfig) ([]*Webhook, error) {
+	webhooks := make([]*Webhook, 0, len(cfgs))
+
+	for _, cfg := range cfgs {
+		w, err := newWebhook(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook %q: %w", cfg.Name, err)
+		}
+
+		if slices.ContainsFunc(webhooks, func(o *Webhook) bool { return o.Name == w.Name }) {
+			return nil, fmt.Errorf("duplicate webhook name %q", w.Name)
+		}
+
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func newWebhook(cfg config.WebhookConfig) (*Webhook, error) {
+	w := &Webhook{
+		Name:        cfg.Name,
+		URLs:        cfg.URLs,
+		Secret:      cfg.Secret,
+		MaxAttempts: cfg.MaxAttempts,
+		Timeout:     cfg.Timeout,
+	}
+
+	if w.MaxAttempts == 0 {
+		w.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if w.Timeout == 0 {
+		w.Timeout = DefaultTimeout
+	}
+
+	for _, name := range cfg.Events {
+		eventType := event.EventType(name)
+		if !slices.Contains(event.AllEventTypes, eventType) {
+			return nil, fmt.Errorf("unknown event type %q", name)
+		}
+
+		w.EventTypes = append(w.EventTypes, eventType)
+	}
+
+	if len(w.EventTypes) == 0 {
+		for _, eventType := range event.AllEventTypes {
+			if eventType != event.PomodoroTick {
+				w.EventTypes = append(w.EventTypes, eventType)
+			}
+		}
+	}
+
+	if cfg.Template != "" {
+		tmpl, err := httppost.ParseTemplate(cfg.Name, cfg.Template)
+		if err != nil {
+			return nil, err
+		}
+
+		w.template = tmpl
+	}
+
+	return w, nil
+}
+
+// Subscribes reports whether the webhook sends events of the type.
+func (w *Webhook) Subscribes(eventType event.EventType) bool {
+	return slices.Contains(w.EventTypes, eventType)
+}
+
+// Render renders the payload of an event. The payload is compacted, so it can be stored and resent byte for byte.
+func (w *Webhook) Render(e event.EventInfo, test bool) ([]byte, error) {
+	payload := Payload{
+		Webhook:  w.Name,
+		Type:     e.GetEventType(),
+		Sequence: e.GetSequence(),
+		Test:     test,
+		Event:    e,
+	}
+
+	switch evt := e.(type) {
+	case event.PomodoroEvent:
+		payload.Timestamp = evt.Timestamp
+	case event.TaskEvent:
+		payload.Timestamp = evt.Timestamp
+	}
+
+	if w.template == nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		return data, nil
+	}
+
+	return httppost.RenderJSON(w.template, payload)
+}
+
+// Sign returns the value of SignatureHeader for a body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the value of SignatureHeader for a body, e.g. in a receiver.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// SampleEvent returns an event of the type with sample data for testing a webhook.
+//
+//nolint:ireturn,mnd
+func SampleEvent(eventType event.EventType, now time.Time) event.EventInfo {
+	base := event.BaseEvent{Type: eventType, Timestamp: now}
+
+	if strings.HasPrefix(string(eventType), "task.") {
+		return event.TaskEvent{
+			BaseEvent: base,
+			ID:        "00000000-0000-0000-0000-000000000000",
+			Title:     "webhook test",
+			Status:    event.TaskStatusTodo,
+		}
+	}
+
+	return event.PomodoroEvent{
+		BaseEvent:      base,
+		ID:             "00000000-0000-0000-0000-000000000000",
+		State:          event.PomodoroStateFinished,
+		ElapsedTime:    25 * time.Minute,
+		Phase:          event.PomodoroPhaseWork,
+		PhaseCount:     1,
+		PhaseDuration:  25 * time.Minute,
+		BreakFrequency: config.DefaultBreakFrequency,
+	}
+}
@@ -0,0 +1,135 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hatappi/gomodoro/internal/config"
+	"github.com/hatappi/gomodoro/internal/core/event"
+	"github.com/hatappi/gomodoro/internal/webhook"
+)
+
+var epoch = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	webhooks, err := webhook.New([]config.WebhookConfig{
+		{Name: "all", URLs: []string{"http://localhost/all"}},
+		{Name: "done", URLs: []string{"http://localhost/done"}, Events: []string{"pomodoro.completed"}, MaxAttempts: 1},
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	all, done := webhooks[0], webhooks[1]
+
+	if all.Subscribes(event.PomodoroTick) || !all.Subscribes(event.TaskCreated) {
+		t.Errorf("webhook without events subscribes to %v, want every event except ticks", all.EventTypes)
+	}
+
+	if all.MaxAttempts != webhook.DefaultMaxAttempts || all.Timeout != webhook.DefaultTimeout {
+		t.Errorf("webhook defaults = %d attempts, %v timeout", all.MaxAttempts, all.Timeout)
+	}
+
+	if !done.Subscribes(event.PomodoroCompleted) || done.Subscribes(event.PomodoroStarted) || done.MaxAttempts != 1 {
+		t.Errorf("webhook done = %+v, want only pomodoro.completed and 1 attempt", done)
+	}
+
+	invalid := map[string][]config.WebhookConfig{
+		"unknown event": {{Name: "a", URLs: []string{"http://localhost"}, Events: []string{"pomodoro.exploded"}}},
+		"bad template":  {{Name: "a", URLs: []string{"http://localhost"}, Template: "{{ .Event"}},
+		"duplicate name": {
+			{Name: "a", URLs: []string{"http://localhost"}},
+			{Name: "a", URLs: []string{"http://localhost"}},
+		},
+	}
+
+	for name, cfgs := range invalid {
+		if _, err := webhook.New(cfgs); err == nil {
+			t.Errorf("New() with %s returned no error", name)
+		}
+	}
+}
+
+func TestWebhookRender(t *testing.T) {
+	t.Parallel()
+
+	webhooks, err := webhook.New([]config.WebhookConfig{
+		{Name: "default", URLs: []string{"http://localhost"}},
+		{
+			Name: "chat",
+			URLs: []string{"http://localhost"},
+			Template: `{
+				"text": {{ json (printf "%s finished %s" .Event.ID .Type) }},
+				"test": {{ .Test }}
+			}`,
+		},
+		{Name: "broken", URLs: []string{"http://localhost"}, Template: `text: {{ .Type }}`},
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	e := event.PomodoroEvent{
+		BaseEvent: event.BaseEvent{Type: event.PomodoroCompleted, Timestamp: epoch, Sequence: 7},
+		ID:        "p1",
+		Phase:     event.PomodoroPhaseWork,
+	}
+
+	body, err := webhooks[0].Render(e, false)
+	if err != nil {
+		t.Fatalf("Render() returned error: %v", err)
+	}
+
+	var payload struct {
+		Webhook   string          `json:"webhook"`
+		Type      string          `json:"type"`
+		Timestamp time.Time       `json:"timestamp"`
+		Sequence  int64           `json:"sequence"`
+		Test      bool            `json:"test"`
+		Data      json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("default payload %s is not JSON: %v", body, err)
+	}
+
+	if payload.Webhook != "default" || payload.Type != "pomodoro.completed" || !payload.Timestamp.Equal(epoch) ||
+		payload.Sequence != 7 || payload.Test {
+		t.Errorf("default payload = %s", body)
+	}
+
+	var data event.PomodoroEvent
+	if err := json.Unmarshal(payload.Data, &data); err != nil || data.ID != "p1" || data.Phase != event.PomodoroPhaseWork {
+		t.Errorf("default payload data = %s, want the event", payload.Data)
+	}
+
+	body, err = webhooks[1].Render(e, true)
+	if err != nil {
+		t.Fatalf("Render() returned error: %v", err)
+	}
+
+	if want := `{"text":"p1 finished pomodoro.completed","test":true}`; string(body) != want {
+		t.Errorf("templated payload = %s, want %s", body, want)
+	}
+
+	if _, err := webhooks[2].Render(e, false); err == nil {
+		t.Error("Render() of a template that does not produce JSON returned no error")
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"type":"pomodoro.completed"}`)
+	signature := webhook.Sign("secret", body)
+
+	if !webhook.Verify("secret", body, signature) {
+		t.Errorf("Verify() rejected its own signature %s", signature)
+	}
+
+	if webhook.Verify("other", body, signature) || webhook.Verify("secret", []byte(`{}`), signature) {
+		t.Error("Verify() accepted a signature of another secret or body")
+	}
+}